@@ -10,9 +10,16 @@ import (
 	"go.uber.org/zap"
 
 	"movieService/internal/config"
+	grpcServer "movieService/internal/delivery/grpc/server"
 	"movieService/internal/delivery/http/middleware"
 	"movieService/internal/delivery/http/server"
+	"movieService/internal/health"
+	"movieService/internal/jobs"
+	"movieService/internal/logger"
+	"movieService/internal/metrics"
+	"movieService/internal/repository/cache"
 	"movieService/internal/repository/postgres"
+	"movieService/internal/tracing"
 	"movieService/internal/usecase"
 	"movieService/pkg/jwt"
 )
@@ -24,12 +31,40 @@ func New() *fx.App {
 			// базовые
 			context.Background,
 			config.NewConfig,
-			zap.NewDevelopment,
+			logger.New,
+
+			// OpenTelemetry: провайдер трейсов и экспортёр из конфига
+			tracing.New,
+
+			// Prometheus-метрики, общие для HTTP, usecase и пула БД
+			metrics.New,
 
 			// Postgres-репозиторий и его интерфейс
 			postgres.NewRepository,
-			func(r *postgres.Repository) postgres.InterfaceRepository {
-				return r
+			// при Redis.enabled чтение фильмов идёт через кэш
+			func(lc fx.Lifecycle, cfg *config.Config, log *zap.Logger, r *postgres.Repository) postgres.InterfaceRepository {
+				if !cfg.Redis.Enabled {
+					return r
+				}
+				redis := cache.NewRedis(cfg)
+				lc.Append(fx.Hook{OnStop: redis.OnStop})
+				return cache.NewRepository(r, redis, log.Named("cache"), cfg.Redis.MovieTTL, cfg.Redis.ListTTL)
+			},
+
+			// фоновая очистка мягко удалённых фильмов
+			func(cfg *config.Config, log *zap.Logger, repo postgres.InterfaceRepository) *jobs.Purger {
+				return jobs.NewPurger(log.Named("purge"), cfg.SoftDelete, repo.PurgeDeletedMovies)
+			},
+			// фоновая публикация запланированных фильмов
+			func(cfg *config.Config, log *zap.Logger, repo postgres.InterfaceRepository) *jobs.Publisher {
+				return jobs.NewPublisher(log.Named("publish"), cfg.Publishing, repo.ApplyMovieSchedule)
+			},
+
+			// проверки готовности: /readyz и grpc.health.v1
+			func(cfg *config.Config, repo *postgres.Repository) *health.Checker {
+				return health.NewChecker(cfg.Server.ReadinessTimeout,
+					health.Dependency{Name: "postgres", Check: repo.HealthCheck},
+				)
 			},
 
 			// JWT-сервис из конфига
@@ -37,23 +72,55 @@ func New() *fx.App {
 				return jwt.NewJWT(cfg.JWT.Secret, cfg.JWT.TTL)
 			},
 
-			// Usecase и его интерфейс
+			// Usecase и его интерфейс (с метриками по каждому методу)
 			usecase.NewUsecase,
-			func(u *usecase.Usecase) usecase.InterfaceUsecase {
-				return u
+			func(u *usecase.Usecase, m *metrics.Metrics) usecase.InterfaceUsecase {
+				return usecase.Instrument(u, m)
 			},
 
 			// HTTP-мiddleware и сервер
 			middleware.NewMiddleware,
 			server.NewServer,
+
+			// gRPC-сервер поверх того же usecase
+			grpcServer.NewServer,
 		),
-		// Lifecycle: сначала поднимаем репозиторий
+		// эффективный конфиг без секретов — для разбора проблем с окружением
+		fx.Invoke(func(log *zap.Logger, cfg *config.Config) {
+			log.Debug("config loaded", zap.Any("config", cfg.Redacted()))
+		}),
+		// Lifecycle: трейсинг поднимается первым и останавливается последним, чтобы дописать спаны
+		fx.Invoke(func(lc fx.Lifecycle, tp *tracing.Provider) {
+			lc.Append(fx.Hook{
+				OnStart: tp.OnStart,
+				OnStop:  tp.OnStop,
+			})
+		}),
+		// затем поднимаем репозиторий
 		fx.Invoke(func(lc fx.Lifecycle, repo *postgres.Repository) {
 			lc.Append(fx.Hook{
 				OnStart: repo.OnStart,
 				OnStop:  repo.OnStop,
 			})
 		}),
+		// метрики пула снимаются при каждом сборе, пока пул не создан — пропускаются
+		fx.Invoke(func(m *metrics.Metrics, repo *postgres.Repository) {
+			m.RegisterPool(repo.Stat)
+		}),
+		// очистка стартует после репозитория и останавливается раньше него
+		fx.Invoke(func(lc fx.Lifecycle, p *jobs.Purger) {
+			lc.Append(fx.Hook{
+				OnStart: p.OnStart,
+				OnStop:  p.OnStop,
+			})
+		}),
+		// публикация по расписанию — так же, после репозитория
+		fx.Invoke(func(lc fx.Lifecycle, p *jobs.Publisher) {
+			lc.Append(fx.Hook{
+				OnStart: p.OnStart,
+				OnStop:  p.OnStop,
+			})
+		}),
 		// --- Hook server lifecycle ---
 		fx.Invoke(func(lc fx.Lifecycle, srv *server.Server) {
 			lc.Append(fx.Hook{
@@ -62,6 +129,14 @@ func New() *fx.App {
 			})
 		}),
 
+		// --- Hook gRPC server lifecycle ---
+		fx.Invoke(func(lc fx.Lifecycle, srv *grpcServer.Server) {
+			lc.Append(fx.Hook{
+				OnStart: srv.OnStart,
+				OnStop:  srv.OnStop,
+			})
+		}),
+
 		// --- Use Zap logger for Fx events ---
 		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
 			return &fxevent.ZapLogger{Logger: log}
@@ -1,7 +1,6 @@
 package config
 
 import (
-	"fmt"
 	"github.com/spf13/viper"
 	"log/slog"
 )
@@ -13,14 +12,57 @@ func NewConfig() (*Config, error) {
 	v.SetConfigName("config")
 	v.SetConfigType("yml")
 
+	// таймауты HTTP-сервера по умолчанию
+	v.SetDefault("server.readTimeout", "10s")
+	v.SetDefault("server.writeTimeout", "30s")
+	v.SetDefault("server.idleTimeout", "120s")
+	v.SetDefault("server.readinessTimeout", "2s")
+
+	v.SetDefault("redis.movieTTL", "5m")
+	v.SetDefault("redis.listTTL", "1m")
+
+	v.SetDefault("logging.level", "info")
+	v.SetDefault("logging.format", "console")
+
+	// трейсинг по умолчанию выключен
+	v.SetDefault("tracing.serviceName", "movieService")
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.sampleRatio", 1.0)
+
+	// лимиты валидации по умолчанию; совпадают с размерами колонок в БД
+	v.SetDefault("validation.titleMaxLength", 255)
+	v.SetDefault("validation.descriptionMaxLength", 5000)
+	v.SetDefault("validation.durationMaxMin", 1000)
+	v.SetDefault("validation.maxGenres", 10)
+	v.SetDefault("validation.commentMaxLength", 2000)
+	v.SetDefault("validation.commentMaxDepth", 5)
+	v.SetDefault("validation.genreNameMaxLength", 100)
+	v.SetDefault("validation.personNameMaxLength", 255)
+	v.SetDefault("validation.personBioMaxLength", 5000)
+	v.SetDefault("validation.maxCredits", 200)
+
+	// мягко удалённые фильмы по умолчанию не очищаются
+	v.SetDefault("softDelete.purgeAfter", "0s")
+	v.SetDefault("softDelete.purgeInterval", "1h")
+	v.SetDefault("softDelete.purgeBatch", 100)
+
+	// запланированные фильмы публикуются и снимаются с публикации с точностью до минуты
+	v.SetDefault("publishing.interval", "1m")
+
+	// исходные названия и описания фильмов — на русском
+	v.SetDefault("locale.default", "ru")
+
+	// возрастные ограничения по умолчанию проверяются по российским рейтингам (0+, 6+, 12+, 16+, 18+)
+	v.SetDefault("certification.defaultCountry", "RU")
+
 	err := v.ReadInConfig()
 	if err != nil {
-		slog.Error("fail to read config", err)
+		slog.Error("fail to read config", "error", err)
 		return &cfg, err
 	}
 	err = v.Unmarshal(&cfg)
 	if err != nil {
-		slog.Error("", fmt.Errorf("unable to decode config into struct, %w", err))
+		slog.Error("unable to decode config into struct", "error", err)
 		return &cfg, err
 	}
 	return &cfg, nil
@@ -3,10 +3,18 @@ package config
 import "time"
 
 type Config struct {
-	Server   ServerConfig   `yaml:"Server"`
-	Postgres PostgresConfig `yaml:"Postgres"`
-	JWT      JWTConfig      `yaml:"JWT"`
-	Secret   string         `yaml:"Secret"`
+	Server        ServerConfig        `yaml:"Server"`
+	Postgres      PostgresConfig      `yaml:"Postgres"`
+	Redis         RedisConfig         `yaml:"Redis"`
+	JWT           JWTConfig           `yaml:"JWT"`
+	Validation    ValidationConfig    `yaml:"Validation"`
+	Tracing       TracingConfig       `yaml:"Tracing"`
+	Logging       LoggingConfig       `yaml:"Logging"`
+	SoftDelete    SoftDeleteConfig    `yaml:"SoftDelete"`
+	Locale        LocaleConfig        `yaml:"Locale"`
+	Publishing    PublishingConfig    `yaml:"Publishing"`
+	Certification CertificationConfig `yaml:"Certification"`
+	Secret        string              `yaml:"Secret"`
 }
 
 type JWTConfig struct {
@@ -24,9 +32,104 @@ type PostgresConfig struct {
 	DSN      string `yaml:"-"` // "-" означает, что это поле не будет загружаться из YAML
 }
 
+// RedisConfig — кэш фильмов поверх Postgres. При недоступном Redis чтение идёт напрямую в БД.
+type RedisConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Host     string        `yaml:"host"`
+	Port     string        `yaml:"port"`
+	Password string        `yaml:"password"`
+	DB       int           `yaml:"db"`
+	MovieTTL time.Duration `yaml:"movieTTL"` // время жизни карточки фильма
+	ListTTL  time.Duration `yaml:"listTTL"`  // время жизни страницы списка фильмов
+}
+
 type ServerConfig struct {
 	AppVersion string `yaml:"appVersion"`
 	Host       string `yaml:"host" validate:"required"`
-	Port       string `yaml:"port" validate:"required"`
-	HTTPPort   string `yaml:"httpPort"`
+	Port       string `yaml:"port" validate:"required"` // порт gRPC-сервера
+	HTTPPort   string `yaml:"httpPort"`                 // порт HTTP-сервера в виде ":8081"
+
+	// таймауты HTTP-сервера, см. http.Server
+	ReadTimeout  time.Duration `yaml:"readTimeout"`
+	WriteTimeout time.Duration `yaml:"writeTimeout"`
+	IdleTimeout  time.Duration `yaml:"idleTimeout"`
+	// сколько ждать после перехода в not-ready, прежде чем перестать принимать запросы,
+	// чтобы балансировщик успел убрать инстанс из ротации
+	ShutdownDelay time.Duration `yaml:"shutdownDelay"`
+	// таймаут проверки одной зависимости в /readyz и grpc.health.v1
+	ReadinessTimeout time.Duration `yaml:"readinessTimeout"`
+}
+
+// LoggingConfig — настройки zap-логгера.
+type LoggingConfig struct {
+	Level  string `yaml:"level"`  // debug, info, warn, error
+	Format string `yaml:"format"` // json — для сборщиков логов, console — для локального запуска
+	// сэмплирование: в пределах секунды из одинаковых сообщений пишутся первые SamplingInitial,
+	// затем каждое SamplingThereafter-е; SamplingInitial = 0 выключает сэмплирование
+	SamplingInitial    int `yaml:"samplingInitial"`
+	SamplingThereafter int `yaml:"samplingThereafter"`
+}
+
+// TracingConfig — экспорт трейсов OpenTelemetry.
+type TracingConfig struct {
+	ServiceName string  `yaml:"serviceName"`
+	Exporter    string  `yaml:"exporter"`    // none — трейсы не пишутся, stdout — в консоль, otlp — в коллектор по gRPC
+	Endpoint    string  `yaml:"endpoint"`    // адрес OTLP-коллектора, например "otel-collector:4317"
+	Insecure    bool    `yaml:"insecure"`    // без TLS до коллектора
+	SampleRatio float64 `yaml:"sampleRatio"` // доля сэмплируемых корневых трейсов, от 0 до 1
+}
+
+// ValidationConfig — ограничения на входные данные usecase. 0 — без ограничения.
+type ValidationConfig struct {
+	TitleMaxLength       int `yaml:"titleMaxLength"`       // символов в названии фильма
+	DescriptionMaxLength int `yaml:"descriptionMaxLength"` // символов в описании фильма
+	DurationMaxMin       int `yaml:"durationMaxMin"`       // максимальная длительность, минут
+	MaxGenres            int `yaml:"maxGenres"`            // жанров у одного фильма
+	CommentMaxLength     int `yaml:"commentMaxLength"`     // символов в комментарии
+	CommentMaxDepth      int `yaml:"commentMaxDepth"`      // уровней вложенности ответов
+	GenreNameMaxLength   int `yaml:"genreNameMaxLength"`   // символов в названии жанра
+	PersonNameMaxLength  int `yaml:"personNameMaxLength"`  // символов в имени персоны
+	PersonBioMaxLength   int `yaml:"personBioMaxLength"`   // символов в биографии персоны
+	MaxCredits           int `yaml:"maxCredits"`           // участников в титрах одного фильма
+}
+
+// SoftDeleteConfig — фоновая очистка мягко удалённых фильмов.
+type SoftDeleteConfig struct {
+	PurgeAfter    time.Duration `yaml:"purgeAfter"`    // сколько хранить удалённый фильм; 0 — не очищать автоматически
+	PurgeInterval time.Duration `yaml:"purgeInterval"` // период запуска очистки
+	PurgeBatch    int           `yaml:"purgeBatch"`    // фильмов за один запуск
+}
+
+// PublishingConfig — фоновая смена состояния запланированных фильмов.
+type PublishingConfig struct {
+	Interval time.Duration `yaml:"interval"` // период проверки publish_at и unpublish_at; 0 — задача выключена
+}
+
+// LocaleConfig — локализация названий и описаний фильмов.
+type LocaleConfig struct {
+	Default string `yaml:"default"` // язык текста в таблице movies; отдаётся, если подходящего перевода нет
+}
+
+// CertificationConfig — возрастные рейтинги фильмов.
+type CertificationConfig struct {
+	// страна, по рейтингам которой работают фильтры max_age и certifications, если она не задана
+	// в запросе или в токене детского профиля; код ISO 3166-1 alpha-2
+	DefaultCountry string `yaml:"defaultCountry"`
+}
+
+// Redacted возвращает копию конфига со скрытыми паролями и ключами — для записи в лог.
+func (c Config) Redacted() Config {
+	c.Postgres.Password = mask(c.Postgres.Password)
+	c.Postgres.DSN = mask(c.Postgres.DSN)
+	c.Redis.Password = mask(c.Redis.Password)
+	c.JWT.Secret = mask(c.JWT.Secret)
+	c.Secret = mask(c.Secret)
+	return c
+}
+
+func mask(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "***"
 }
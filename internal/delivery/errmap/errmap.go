@@ -0,0 +1,73 @@
+// Package errmap — единый транслятор доменных ошибок errs в коды HTTP и gRPC.
+package errmap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"movieService/internal/errs"
+)
+
+type mapping struct {
+	http int
+	grpc codes.Code
+}
+
+// table — соответствие кодов доменных ошибок статусам транспортов.
+var table = map[errs.Code]mapping{
+	errs.CodeInternal:         {http.StatusInternalServerError, codes.Internal},
+	errs.CodeNotFound:         {http.StatusNotFound, codes.NotFound},
+	errs.CodeConflict:         {http.StatusConflict, codes.AlreadyExists},
+	errs.CodeInvalidArgument:  {http.StatusBadRequest, codes.InvalidArgument},
+	errs.CodeUnauthenticated:  {http.StatusUnauthorized, codes.Unauthenticated},
+	errs.CodePermissionDenied: {http.StatusForbidden, codes.PermissionDenied},
+	errs.CodeUnavailable:      {http.StatusServiceUnavailable, codes.Unavailable},
+}
+
+func lookup(code errs.Code) mapping {
+	if m, ok := table[code]; ok {
+		return m
+	}
+	return table[errs.CodeInternal]
+}
+
+// HTTP возвращает HTTP-статус, код и сообщение для тела ответа об ошибке.
+func HTTP(err error) (statusCode int, code errs.Code, message string) {
+	code = errs.CodeOf(err)
+	return lookup(code).http, code, errs.MessageOf(err)
+}
+
+// GRPC переводит ошибку в gRPC status. Ошибки, уже являющиеся status, возвращаются как есть.
+// Нарушения валидации передаются в деталях статуса как google.rpc.BadRequest.
+func GRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	// запрос отменён клиентом — это не ошибка сервиса
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	st := status.New(lookup(errs.CodeOf(err)).grpc, errs.MessageOf(err))
+	if violations := errs.ViolationsOf(err); len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Message,
+				Reason:      v.Rule,
+			})
+		}
+		if withDetails, detailsErr := st.WithDetails(badRequest); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
@@ -0,0 +1,67 @@
+package errmap_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"movieService/internal/delivery/errmap"
+	"movieService/internal/errs"
+)
+
+func TestHTTPDomainError(t *testing.T) {
+	err := fmt.Errorf("%w: unknown sort_by %q", errs.New(errs.CodeInvalidArgument, "invalid sort"), "foo")
+
+	code, kind, message := errmap.HTTP(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, errs.CodeInvalidArgument, kind)
+	assert.Equal(t, `invalid sort: unknown sort_by "foo"`, message)
+	assert.ErrorIs(t, err, errs.ErrInvalidArgument)
+}
+
+func TestHTTPHidesCause(t *testing.T) {
+	err := errs.Wrap(errs.CodeUnavailable, errors.New("dial tcp 10.0.0.1:5432: connection refused"), "database is unavailable")
+
+	code, kind, message := errmap.HTTP(err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, errs.CodeUnavailable, kind)
+	assert.Equal(t, "database is unavailable", message)
+}
+
+func TestHTTPUnknownErrorIsInternal(t *testing.T) {
+	code, kind, message := errmap.HTTP(errors.New("boom"))
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, errs.CodeInternal, kind)
+	assert.Equal(t, "internal error", message)
+}
+
+func TestGRPC(t *testing.T) {
+	st, ok := status.FromError(errmap.GRPC(errs.NotFound("movie %d not found", 5)))
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "movie 5 not found", st.Message())
+
+	already := status.Error(codes.Aborted, "aborted")
+	assert.Equal(t, already, errmap.GRPC(already))
+	assert.NoError(t, errmap.GRPC(nil))
+}
+
+func TestGRPCViolations(t *testing.T) {
+	err := errs.Validation([]errs.Violation{{Field: "title", Rule: "required", Message: "title is required"}})
+
+	st, ok := status.FromError(errmap.GRPC(err))
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		assert.Equal(t, "title", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "required", badRequest.GetFieldViolations()[0].GetReason())
+	}
+}
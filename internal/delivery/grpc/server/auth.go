@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"movieService/internal/delivery/policy"
+	"movieService/internal/errs"
+	"movieService/internal/logger"
+	JWT "movieService/pkg/jwt"
+)
+
+// authInterceptor — аналог middleware.Auth + RequireRole для gRPC: проверяет Bearer-JWT
+// из метаданных authorization, роль по policy.Table и кладёт данные пользователя в контекст.
+// Для незащищённых методов токен необязателен, но если передан, то должен быть валидным.
+func (s *Server) authInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	role, protected := policy.RequiredRole(methodName(info.FullMethod))
+
+	token, found := bearerToken(ctx)
+	if !found {
+		if protected {
+			return nil, errs.Unauthenticated("authorization metadata is missing")
+		}
+		return handler(ctx, req)
+	}
+
+	claims, err := s.jwt.Validate(token)
+	if err != nil {
+		logger.FromContext(ctx, s.log).Error("Auth: invalid access token", zap.Error(err))
+		return nil, errs.Unauthenticated("invalid token")
+	}
+	if protected && !JWT.HasRole(claims.Role, role) {
+		logger.FromContext(ctx, s.log).Warn("Auth: insufficient role",
+			zap.String("role", claims.Role),
+			zap.String("required", role),
+			zap.String("method", info.FullMethod),
+		)
+		return nil, errs.PermissionDenied("insufficient role")
+	}
+	return handler(JWT.WithClaims(ctx, claims), req)
+}
+
+// bearerToken достаёт токен из метаданных вида "authorization: Bearer <token>".
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	parts := strings.SplitN(values[0], " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// methodName из полного имени вида "/movie_proto.v1.MovieService/CreateMovie" возвращает "CreateMovie".
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"movieService/internal/health"
+	"movieService/internal/logger"
+)
+
+// healthServer — grpc.health.v1 поверх стандартного health.Server: статусы для Watch
+// выставляются при старте и остановке, а Check дополнительно опрашивает зависимости.
+type healthServer struct {
+	*grpcHealth.Server
+
+	log     *zap.Logger
+	checker *health.Checker
+}
+
+// Check возвращает NOT_SERVING, если сервер останавливается или недоступна какая-то зависимость.
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	resp, err := h.Server.Check(ctx, req)
+	if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return resp, err
+	}
+	if report := h.checker.Check(ctx); !report.Up() {
+		logger.FromContext(ctx, h.log).Warn("readiness check failed", zap.Any("checks", report.Checks))
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,366 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"movieService/internal/config"
+	"movieService/internal/delivery/errmap"
+	"movieService/internal/health"
+	"movieService/internal/logger"
+	"movieService/internal/usecase"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// Server — gRPC-реализация MovieService поверх usecase.InterfaceUsecase.
+type Server struct {
+	protos.UnimplementedMovieServiceServer
+
+	log        *zap.Logger
+	cfg        *config.Config
+	serv       *grpc.Server
+	jwt        JWT.InterfaceJWT
+	shutdowner fx.Shutdowner
+	health     *grpcHealth.Server
+	Usecase    usecase.InterfaceUsecase
+}
+
+var _ protos.MovieServiceServer = (*Server)(nil)
+
+func NewServer(logger *zap.Logger, cfg *config.Config, uc usecase.InterfaceUsecase, jwt JWT.InterfaceJWT, shutdowner fx.Shutdowner, checker *health.Checker) (*Server, error) {
+	s := &Server{
+		log:        logger,
+		cfg:        cfg,
+		jwt:        jwt,
+		shutdowner: shutdowner,
+		health:     grpcHealth.NewServer(),
+		Usecase:    uc,
+	}
+	s.serv = grpc.NewServer(
+		// спаны и W3C-пропагация из метаданных запроса
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(s.errorInterceptor, s.requestIDInterceptor, s.loggingInterceptor, s.authInterceptor),
+	)
+	protos.RegisterMovieServiceServer(s.serv, s)
+	healthpb.RegisterHealthServer(s.serv, &healthServer{Server: s.health, log: s.log, checker: checker})
+	return s, nil
+}
+
+func (s *Server) OnStart(_ context.Context) error {
+	addr := s.cfg.Server.Host + ":" + s.cfg.Server.Port
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen grpc %s: %w", addr, err)
+	}
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus(protos.MovieService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	go func() {
+		s.log.Debug("grpc server started", zap.String("addr", addr))
+		if err := s.serv.Serve(lis); err != nil {
+			s.log.Error("failed to serve grpc", zap.Error(err))
+			if err := s.shutdowner.Shutdown(fx.ExitCode(1)); err != nil {
+				s.log.Error("failed to shutdown app", zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *Server) OnStop(ctx context.Context) error {
+	s.log.Debug("stop grpc server")
+	// клиенты health-проверок сразу видят NOT_SERVING, пока идёт дренаж
+	s.health.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		s.serv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.serv.Stop()
+	}
+	return nil
+}
+
+// requestIDInterceptor — аналог middleware.RequestID: берёт x-request-id из метаданных
+// (или генерирует новый), возвращает его в заголовках ответа и кладёт в контекст.
+func (s *Server) requestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var incoming string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logger.RequestIDHeader); len(values) > 0 {
+			incoming = values[0]
+		}
+	}
+	id := logger.RequestID(incoming)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIDHeader, id)); err != nil {
+		s.log.Debug("failed to set request id header", zap.Error(err))
+	}
+	return handler(logger.WithRequestID(ctx, id), req)
+}
+
+// loggingInterceptor пишет в лог имя метода, длительность и ошибку вызова.
+func (s *Server) loggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	log := logger.FromContext(ctx, s.log)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if err != nil {
+		log.Error("grpc call failed",
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err),
+		)
+		return resp, err
+	}
+	log.Debug("grpc call",
+		zap.String("method", info.FullMethod),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return resp, nil
+}
+
+// errorInterceptor переводит доменные ошибки usecase и репозитория в gRPC status через errmap.
+func (s *Server) errorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, errmap.GRPC(err)
+}
+
+// --- Movie ---
+
+// ListMovies возвращает постраничный список фильмов.
+func (s *Server) ListMovies(ctx context.Context, req *protos.ListMoviesRequest) (*protos.ListMoviesResponse, error) {
+	return s.Usecase.ListMovies(ctx, req)
+}
+
+// GetMovie возвращает фильм по его ID.
+func (s *Server) GetMovie(ctx context.Context, req *protos.GetMovieRequest) (*protos.Movie, error) {
+	return s.Usecase.GetMovie(ctx, req)
+}
+
+// CreateMovie создаёт новый фильм.
+func (s *Server) CreateMovie(ctx context.Context, req *protos.CreateMovieRequest) (*protos.CreateMovieResponse, error) {
+	return s.Usecase.CreateMovie(ctx, req)
+}
+
+// DeleteMovie мягко удаляет фильм по его ID.
+func (s *Server) DeleteMovie(ctx context.Context, req *protos.DeleteMovieRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeleteMovie(ctx, req)
+}
+
+// RestoreMovie восстанавливает мягко удалённый фильм.
+func (s *Server) RestoreMovie(ctx context.Context, req *protos.RestoreMovieRequest) (*protos.Movie, error) {
+	return s.Usecase.RestoreMovie(ctx, req)
+}
+
+// PurgeMovie окончательно удаляет мягко удалённый фильм.
+func (s *Server) PurgeMovie(ctx context.Context, req *protos.PurgeMovieRequest) (*emptypb.Empty, error) {
+	return s.Usecase.PurgeMovie(ctx, req)
+}
+
+// PublishMovie публикует фильм сразу.
+func (s *Server) PublishMovie(ctx context.Context, req *protos.PublishMovieRequest) (*protos.Movie, error) {
+	return s.Usecase.PublishMovie(ctx, req)
+}
+
+// ScheduleMovie планирует публикацию фильма.
+func (s *Server) ScheduleMovie(ctx context.Context, req *protos.ScheduleMovieRequest) (*protos.Movie, error) {
+	return s.Usecase.ScheduleMovie(ctx, req)
+}
+
+// ArchiveMovie снимает фильм с публикации.
+func (s *Server) ArchiveMovie(ctx context.Context, req *protos.ArchiveMovieRequest) (*protos.Movie, error) {
+	return s.Usecase.ArchiveMovie(ctx, req)
+}
+
+// RevertMovieToDraft возвращает фильм в черновики.
+func (s *Server) RevertMovieToDraft(ctx context.Context, req *protos.RevertMovieToDraftRequest) (*protos.Movie, error) {
+	return s.Usecase.RevertMovieToDraft(ctx, req)
+}
+
+// UpdateMovie полностью заменяет данные фильма.
+func (s *Server) UpdateMovie(ctx context.Context, req *protos.UpdateMovieRequest) (*protos.UpdateMovieResponse, error) {
+	return s.Usecase.UpdateMovie(ctx, req)
+}
+
+// PatchMovie частично обновляет фильм по update_mask.
+func (s *Server) PatchMovie(ctx context.Context, req *protos.PatchMovieRequest) (*protos.UpdateMovieResponse, error) {
+	return s.Usecase.PatchMovie(ctx, req)
+}
+
+// SearchMovies ищет фильмы по названию и описанию.
+func (s *Server) SearchMovies(ctx context.Context, req *protos.SearchMoviesRequest) (*protos.SearchMoviesResponse, error) {
+	return s.Usecase.SearchMovies(ctx, req)
+}
+
+// ListMovieTranslations возвращает все переводы фильма.
+func (s *Server) ListMovieTranslations(ctx context.Context, req *protos.ListMovieTranslationsRequest) (*protos.ListMovieTranslationsResponse, error) {
+	return s.Usecase.ListMovieTranslations(ctx, req)
+}
+
+// SetMovieTranslation создаёт или заменяет перевод фильма.
+func (s *Server) SetMovieTranslation(ctx context.Context, req *protos.SetMovieTranslationRequest) (*protos.MovieTranslation, error) {
+	return s.Usecase.SetMovieTranslation(ctx, req)
+}
+
+// DeleteMovieTranslation удаляет перевод фильма.
+func (s *Server) DeleteMovieTranslation(ctx context.Context, req *protos.DeleteMovieTranslationRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeleteMovieTranslation(ctx, req)
+}
+
+// SetMovieCertifications заменяет возрастные рейтинги и описания содержимого фильма.
+func (s *Server) SetMovieCertifications(ctx context.Context, req *protos.SetMovieCertificationsRequest) (*protos.Movie, error) {
+	return s.Usecase.SetMovieCertifications(ctx, req)
+}
+
+// --- Rating ---
+
+// ListRatings возвращает постраничный список оценок фильма.
+func (s *Server) ListRatings(ctx context.Context, req *protos.ListRatingsRequest) (*protos.ListRatingsResponse, error) {
+	return s.Usecase.ListRatings(ctx, req)
+}
+
+// GetRating возвращает оценку по ID фильма и ID оценки.
+func (s *Server) GetRating(ctx context.Context, req *protos.GetRatingRequest) (*protos.Rating, error) {
+	return s.Usecase.GetRating(ctx, req)
+}
+
+// CreateRating создаёт или обновляет оценку пользователя для фильма.
+func (s *Server) CreateRating(ctx context.Context, req *protos.CreateRatingRequest) (*protos.CreateRatingResponse, error) {
+	return s.Usecase.CreateRating(ctx, req)
+}
+
+// GetMyRating возвращает оценку фильма текущим пользователем.
+func (s *Server) GetMyRating(ctx context.Context, req *protos.GetMyRatingRequest) (*protos.Rating, error) {
+	return s.Usecase.GetMyRating(ctx, req)
+}
+
+// DeleteRating удаляет оценку по ID фильма и ID оценки.
+func (s *Server) DeleteRating(ctx context.Context, req *protos.DeleteRatingRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeleteRating(ctx, req)
+}
+
+// --- Comment ---
+
+// ListComments возвращает постраничный список комментариев к фильму.
+func (s *Server) ListComments(ctx context.Context, req *protos.ListCommentsRequest) (*protos.ListCommentsResponse, error) {
+	return s.Usecase.ListComments(ctx, req)
+}
+
+// GetComment возвращает комментарий по ID фильма и ID комментария.
+func (s *Server) GetComment(ctx context.Context, req *protos.GetCommentRequest) (*protos.Comment, error) {
+	return s.Usecase.GetComment(ctx, req)
+}
+
+// CreateComment создаёт новый комментарий к фильму.
+func (s *Server) CreateComment(ctx context.Context, req *protos.CreateCommentRequest) (*protos.CreateCommentResponse, error) {
+	return s.Usecase.CreateComment(ctx, req)
+}
+
+// EditComment заменяет текст комментария его автором.
+func (s *Server) EditComment(ctx context.Context, req *protos.EditCommentRequest) (*protos.Comment, error) {
+	return s.Usecase.EditComment(ctx, req)
+}
+
+// DeleteComment удаляет комментарий по ID фильма и ID комментария.
+func (s *Server) DeleteComment(ctx context.Context, req *protos.DeleteCommentRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeleteComment(ctx, req)
+}
+
+// --- Genre ---
+
+// ListGenres возвращает все жанры с количеством фильмов.
+func (s *Server) ListGenres(ctx context.Context, req *protos.ListGenresRequest) (*protos.ListGenresResponse, error) {
+	return s.Usecase.ListGenres(ctx, req)
+}
+
+// CreateGenre создаёт новый жанр.
+func (s *Server) CreateGenre(ctx context.Context, req *protos.CreateGenreRequest) (*protos.CreateGenreResponse, error) {
+	return s.Usecase.CreateGenre(ctx, req)
+}
+
+// UpdateGenre переименовывает жанр.
+func (s *Server) UpdateGenre(ctx context.Context, req *protos.UpdateGenreRequest) (*protos.UpdateGenreResponse, error) {
+	return s.Usecase.UpdateGenre(ctx, req)
+}
+
+// DeleteGenre удаляет жанр.
+func (s *Server) DeleteGenre(ctx context.Context, req *protos.DeleteGenreRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeleteGenre(ctx, req)
+}
+
+// --- People ---
+
+// ListPeople возвращает постраничный список персон.
+func (s *Server) ListPeople(ctx context.Context, req *protos.ListPeopleRequest) (*protos.ListPeopleResponse, error) {
+	return s.Usecase.ListPeople(ctx, req)
+}
+
+// GetPerson возвращает персону по её ID.
+func (s *Server) GetPerson(ctx context.Context, req *protos.GetPersonRequest) (*protos.Person, error) {
+	return s.Usecase.GetPerson(ctx, req)
+}
+
+// CreatePerson создаёт новую персону.
+func (s *Server) CreatePerson(ctx context.Context, req *protos.CreatePersonRequest) (*protos.CreatePersonResponse, error) {
+	return s.Usecase.CreatePerson(ctx, req)
+}
+
+// UpdatePerson полностью заменяет данные персоны.
+func (s *Server) UpdatePerson(ctx context.Context, req *protos.UpdatePersonRequest) (*protos.UpdatePersonResponse, error) {
+	return s.Usecase.UpdatePerson(ctx, req)
+}
+
+// DeletePerson удаляет персону.
+func (s *Server) DeletePerson(ctx context.Context, req *protos.DeletePersonRequest) (*emptypb.Empty, error) {
+	return s.Usecase.DeletePerson(ctx, req)
+}
+
+// ListFilmography возвращает фильмы, в которых участвовала персона.
+func (s *Server) ListFilmography(ctx context.Context, req *protos.ListFilmographyRequest) (*protos.ListFilmographyResponse, error) {
+	return s.Usecase.ListFilmography(ctx, req)
+}
+
+// SetMovieCredits полностью заменяет титры фильма.
+func (s *Server) SetMovieCredits(ctx context.Context, req *protos.SetMovieCreditsRequest) (*protos.Movie, error) {
+	return s.Usecase.SetMovieCredits(ctx, req)
+}
+
+// --- Series ---
+
+// ListSeries возвращает постраничный список сериалов.
+func (s *Server) ListSeries(ctx context.Context, req *protos.ListSeriesRequest) (*protos.ListSeriesResponse, error) {
+	return s.Usecase.ListSeries(ctx, req)
+}
+
+// GetSeries возвращает сериал с сезонами по его ID.
+func (s *Server) GetSeries(ctx context.Context, req *protos.GetSeriesRequest) (*protos.Series, error) {
+	return s.Usecase.GetSeries(ctx, req)
+}
+
+// ListEpisodes возвращает эпизоды сериала.
+func (s *Server) ListEpisodes(ctx context.Context, req *protos.ListEpisodesRequest) (*protos.ListEpisodesResponse, error) {
+	return s.Usecase.ListEpisodes(ctx, req)
+}
+
+// GetEpisode возвращает эпизод по его ID.
+func (s *Server) GetEpisode(ctx context.Context, req *protos.GetEpisodeRequest) (*protos.Episode, error) {
+	return s.Usecase.GetEpisode(ctx, req)
+}
+
+// GetNextEpisode возвращает эпизод, следующий за указанным.
+func (s *Server) GetNextEpisode(ctx context.Context, req *protos.GetNextEpisodeRequest) (*protos.GetNextEpisodeResponse, error) {
+	return s.Usecase.GetNextEpisode(ctx, req)
+}
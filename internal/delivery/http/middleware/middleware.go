@@ -4,10 +4,14 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"movieService/internal/config"
+	"movieService/internal/delivery/errmap"
+	"movieService/internal/errs"
+	"movieService/internal/logger"
+	"movieService/internal/metrics"
 	"movieService/internal/repository/postgres"
 	JWT "movieService/pkg/jwt"
-	"net/http"
 	"strings"
+	"time"
 
 	_ "movieService/internal/config"
 )
@@ -18,48 +22,177 @@ type Middleware struct {
 	log   *zap.Logger
 	roles map[string]int
 	jwt   JWT.InterfaceJWT
+
+	metrics *metrics.Metrics
 }
 
 var _ JWT.InterfaceJWT = (*JWT.ServiceJWT)(nil)
 
-func NewMiddleware(cfg *config.Config, log *zap.Logger, repository *postgres.Repository, jwt JWT.InterfaceJWT) *Middleware {
+func NewMiddleware(cfg *config.Config, log *zap.Logger, repository *postgres.Repository, jwt JWT.InterfaceJWT, m *metrics.Metrics) *Middleware {
 	return &Middleware{
-		cfg:  cfg,
-		log:  log,
-		repo: repository,
-		jwt:  jwt,
+		cfg:     cfg,
+		log:     log,
+		repo:    repository,
+		roles:   JWT.RoleLevels,
+		jwt:     jwt,
+		metrics: m,
+	}
+}
+
+// Metrics возвращает gin.HandlerFunc, учитывающий число и длительность запросов
+// по методу, шаблону маршрута (c.FullPath) и статусу ответа.
+func (m *Middleware) Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			// несуществующие пути не размножают метки
+			route = "unmatched"
+		}
+		m.metrics.ObserveHTTP(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// RequestID возвращает gin.HandlerFunc, который берёт X-Request-ID из запроса (или генерирует новый),
+// возвращает его в ответе и кладёт в context.Context запроса для логов usecase и репозитория.
+func (m *Middleware) RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := logger.RequestID(c.GetHeader(logger.RequestIDHeader))
+		c.Header(logger.RequestIDHeader, id)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// AccessLog возвращает gin.HandlerFunc, пишущий в zap одну запись на запрос вместо стандартного логгера Gin.
+// Должен стоять после RequestID, чтобы запись содержала request_id.
+func (m *Middleware) AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		logger.FromContext(c.Request.Context(), m.log).Info("http request",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("route", c.FullPath()),
+			zap.Int("status", c.Writer.Status()),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("client_ip", c.ClientIP()),
+		)
+	}
+}
+
+// Recovery возвращает gin.HandlerFunc, который перехватывает панику обработчика,
+// пишет её в лог со стеком и отвечает 500 в общем формате ошибок.
+func (m *Middleware) Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if p := recover(); p != nil {
+				logger.FromContext(c.Request.Context(), m.log).Error("panic recovered",
+					zap.Any("panic", p),
+					zap.Stack("stack"),
+				)
+				abort(c, errs.New(errs.CodeInternal, "internal error"))
+			}
+		}()
+		c.Next()
 	}
 }
 
 // Auth возвращает gin.HandlerFunc, проверяющий Bearer-JWT в заголовке Authorization.
+// Если токен уже проверен OptionalAuth выше по цепочке, повторно он не разбирается.
 func (m *Middleware) Auth() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if _, ok := c.Get("userID"); ok {
+			c.Next()
+			return
+		}
+
 		// 1. Получаем заголовок Authorization
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is missing"})
+			abort(c, errs.Unauthenticated("Authorization header is missing"))
 			return
 		}
 
-		// 2. Должен быть формат "Bearer <token>"
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header format"})
-			return
+		if m.authenticate(c, authHeader) {
+			c.Next()
 		}
-		tokenString := parts[1]
+	}
+}
 
-		// 3. Валидируем токен и получаем userID
-		userID, err := m.jwt.Validate(tokenString)
-		if err != nil {
-			m.log.Error("Auth: invalid access token", zap.Error(err))
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+// OptionalAuth возвращает gin.HandlerFunc для публичных маршрутов: запрос без заголовка Authorization
+// проходит анонимно, а присланный токен проверяется так же строго, как в Auth, — от роли зависит,
+// какие фильмы видит пользователь.
+func (m *Middleware) OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.Next()
 			return
 		}
+		if m.authenticate(c, authHeader) {
+			c.Next()
+		}
+	}
+}
 
-		// 4. Кладём userID в контекст Gin, чтобы контроллеры могли его прочитать
-		c.Set("userID", userID)
+// authenticate проверяет заголовок Authorization и кладёт данные пользователя в контекст.
+// При ошибке прерывает цепочку ответом 401 и возвращает false.
+func (m *Middleware) authenticate(c *gin.Context, authHeader string) bool {
+	// 2. Должен быть формат "Bearer <token>"
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		abort(c, errs.Unauthenticated("Invalid authorization header format"))
+		return false
+	}
+	tokenString := parts[1]
 
-		c.Next()
+	// 3. Валидируем токен и получаем userID и роль
+	claims, err := m.jwt.Validate(tokenString)
+	if err != nil {
+		logger.FromContext(c.Request.Context(), m.log).Error("Auth: invalid access token", zap.Error(err))
+		abort(c, errs.Unauthenticated("Invalid token"))
+		return false
+	}
+
+	// 4. Кладём userID и роль в контекст Gin, чтобы контроллеры могли их прочитать,
+	// и в context.Context запроса — для проверок в usecase
+	c.Set("userID", claims.UserID)
+	c.Set("role", claims.Role)
+	c.Request = c.Request.WithContext(JWT.WithClaims(c.Request.Context(), claims))
+	return true
+}
+
+// RequireRole возвращает gin.HandlerFunc, пропускающий запрос, только если роль пользователя
+// не ниже хотя бы одной из roles. Должен стоять после Auth.
+func (m *Middleware) RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("role")
+		level, known := m.roles[role]
+		if !known {
+			abort(c, errs.PermissionDenied("Unknown role"))
+			return
+		}
+		for _, r := range roles {
+			if level >= m.roles[r] {
+				c.Next()
+				return
+			}
+		}
+		logger.FromContext(c.Request.Context(), m.log).Warn("RequireRole: insufficient role",
+			zap.String("role", role),
+			zap.Strings("required", roles),
+			zap.String("path", c.FullPath()),
+		)
+		abort(c, errs.PermissionDenied("Insufficient role"))
 	}
 }
+
+// abort прерывает цепочку и пишет ошибку в том же формате, что и обработчики сервера.
+func abort(c *gin.Context, err error) {
+	status, code, message := errmap.HTTP(err)
+	c.AbortWithStatusJSON(status, gin.H{"code": code, "message": message})
+}
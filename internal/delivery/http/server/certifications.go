@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// SetMovieCertifications godoc
+// @Summary      Заменить возрастные рейтинги фильма
+// @Description  Полностью заменяет возрастные рейтинги фильма по странам и описания содержимого; пустые списки их удаляют. Требуется роль admin.
+// @Tags         certifications
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                               true  "ID фильма"
+// @Param        input  body      __.SetMovieCertificationsRequest  true  "Рейтинги и описания содержимого"
+// @Success      200    {object}  __.Movie
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/certifications [put]
+func (s *Server) SetMovieCertifications(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "SetMovieCertifications", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.SetMovieCertificationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "SetMovieCertifications", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.MovieId = int32(id)
+	resp, err := s.Usecase.SetMovieCertifications(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "SetMovieCertifications", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ageQuery читает возрастной фильтр списков: max_age и certifications через запятую.
+// Отсутствующий max_age — nil, чтобы отличить его от max_age=0.
+func ageQuery(c *gin.Context) (maxAge *int32, certifications []string, err error) {
+	if raw, ok := c.GetQuery("max_age"); ok {
+		age, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, nil, errs.InvalidArgument("invalid max_age")
+		}
+		v := int32(age)
+		maxAge = &v
+	}
+	if cs := c.Query("certifications"); cs != "" {
+		for _, part := range strings.Split(cs, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				certifications = append(certifications, part)
+			}
+		}
+	}
+	return maxAge, certifications, nil
+}
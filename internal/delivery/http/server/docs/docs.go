@@ -3,5 +3,10 @@ package docs
 // @title           MovieService API
 // @version         1.0
 // @description     REST-документация для MovieService.
-// @host            localhost:8000
+// @host            localhost:8081
 // @BasePath        /api/v1
+
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 JWT в формате "Bearer <token>"
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"movieService/internal/health"
+	"movieService/internal/logger"
+)
+
+// Liveness godoc
+// @Summary      Liveness-проба
+// @Description  Процесс жив и обрабатывает HTTP-запросы. Зависимости не проверяются.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  health.Report
+// @Router       /healthz [get]
+func (s *Server) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, health.Report{Status: health.StatusUp})
+}
+
+// Readiness godoc
+// @Summary      Readiness-проба
+// @Description  Готовность принимать трафик: проверяет зависимости (Postgres — ping и статистика пула).
+// @Description  Возвращает 503, если какая-то зависимость недоступна или сервер останавливается.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  health.Report
+// @Failure      503  {object}  health.Report
+// @Router       /readyz [get]
+func (s *Server) Readiness(c *gin.Context) {
+	if !s.Ready() {
+		c.JSON(http.StatusServiceUnavailable, health.Report{Status: health.StatusDown})
+		return
+	}
+	report := s.health.Check(c.Request.Context())
+	if !report.Up() {
+		logger.FromContext(c.Request.Context(), s.log).Warn("readiness check failed", zap.Any("checks", report.Checks))
+		c.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
@@ -8,15 +8,48 @@ import (
 // Включает запуск/остановку сервера и регистрацию HTTP-обработчиков для каждого usecase.
 type InterfaceServer interface {
 	ListMovies(c *gin.Context)
+	SearchMovies(c *gin.Context)
 	GetMovie(c *gin.Context)
 	CreateMovie(c *gin.Context)
 	DeleteMovie(c *gin.Context)
+	RestoreMovie(c *gin.Context)
+	PurgeMovie(c *gin.Context)
+	PublishMovie(c *gin.Context)
+	ScheduleMovie(c *gin.Context)
+	ArchiveMovie(c *gin.Context)
+	RevertMovieToDraft(c *gin.Context)
+	UpdateMovie(c *gin.Context)
+	PatchMovie(c *gin.Context)
+	ListMovieTranslations(c *gin.Context)
+	SetMovieTranslation(c *gin.Context)
+	DeleteMovieTranslation(c *gin.Context)
+	SetMovieCertifications(c *gin.Context)
 	ListRatings(c *gin.Context)
 	GetRating(c *gin.Context)
 	CreateRating(c *gin.Context)
+	GetMyRating(c *gin.Context)
 	DeleteRating(c *gin.Context)
 	ListComments(c *gin.Context)
 	GetComment(c *gin.Context)
 	CreateComment(c *gin.Context)
+	EditComment(c *gin.Context)
 	DeleteComment(c *gin.Context)
+	ListGenres(c *gin.Context)
+	CreateGenre(c *gin.Context)
+	UpdateGenre(c *gin.Context)
+	DeleteGenre(c *gin.Context)
+	ListPeople(c *gin.Context)
+	GetPerson(c *gin.Context)
+	CreatePerson(c *gin.Context)
+	UpdatePerson(c *gin.Context)
+	DeletePerson(c *gin.Context)
+	ListFilmography(c *gin.Context)
+	SetMovieCredits(c *gin.Context)
+	ListSeries(c *gin.Context)
+	GetSeries(c *gin.Context)
+	ListEpisodes(c *gin.Context)
+	GetEpisode(c *gin.Context)
+	GetNextEpisode(c *gin.Context)
+	Liveness(c *gin.Context)
+	Readiness(c *gin.Context)
 }
@@ -1,5 +1,33 @@
 package server
 
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"movieService/internal/delivery/errmap"
+	"movieService/internal/errs"
+	"movieService/internal/logger"
+)
+
+// errorResponse — тело любого ответа с ошибкой.
 type errorResponse struct {
-	Message string `json:"message"`
+	Code    string `json:"code" example:"NOT_FOUND"`
+	Message string `json:"message" example:"not found"`
+
+	// нарушения по полям, только для ошибок валидации
+	Violations []errs.Violation `json:"violations,omitempty"`
+}
+
+// fail пишет ошибку в ответ: статус и код выбирает errmap по доменной ошибке.
+func (s *Server) fail(c *gin.Context, op string, err error) {
+	status, code, message := errmap.HTTP(err)
+	log := logger.FromContext(c.Request.Context(), s.log)
+	if status >= http.StatusInternalServerError {
+		log.Error(op+" error", zap.Error(err))
+	} else {
+		log.Warn(op+" error", zap.Error(err))
+	}
+	c.JSON(status, errorResponse{Code: string(code), Message: message, Violations: errs.ViolationsOf(err)})
 }
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListPeople godoc
+// @Summary      Список персон
+// @Description  Возвращает постраничный список актёров, режиссёров и сценаристов, отсортированный по имени.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        q        query     string  false  "Подстрока имени без учёта регистра"
+// @Param        page     query     int     false  "Номер страницы"        default(1)
+// @Param        per_page query     int     false  "Элементов на страницу" default(10)
+// @Success      200      {object}  __.ListPeopleResponse
+// @Failure      400      {object}  errorResponse
+// @Failure      500      {object}  errorResponse
+// @Router       /people [get]
+func (s *Server) ListPeople(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	per, _ := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	req := &protos.ListPeopleRequest{
+		Query:   c.Query("q"),
+		Page:    int32(page),
+		PerPage: int32(per),
+	}
+	resp, err := s.Usecase.ListPeople(c.Request.Context(), req)
+	if err != nil {
+		s.fail(c, "ListPeople", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetPerson godoc
+// @Summary      Получить персону
+// @Description  Возвращает персону по её ID.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID персоны"
+// @Success      200  {object}  __.Person
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /people/{id} [get]
+func (s *Server) GetPerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "GetPerson", errs.InvalidArgument("invalid person id"))
+		return
+	}
+	resp, err := s.Usecase.GetPerson(c.Request.Context(), &protos.GetPersonRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "GetPerson", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreatePerson godoc
+// @Summary      Создать персону
+// @Description  Создаёт актёра, режиссёра или сценариста. Требуется роль admin.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        input  body      __.CreatePersonRequest  true  "Данные персоны"
+// @Success      201    {object}  __.CreatePersonResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /people [post]
+func (s *Server) CreatePerson(c *gin.Context) {
+	var req protos.CreatePersonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "CreatePerson", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	resp, err := s.Usecase.CreatePerson(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "CreatePerson", err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// UpdatePerson godoc
+// @Summary      Обновить персону
+// @Description  Полностью заменяет данные персоны по её ID. Требуется роль admin.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                     true  "ID персоны"
+// @Param        input  body      __.UpdatePersonRequest  true  "Новые данные персоны"
+// @Success      200    {object}  __.UpdatePersonResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /people/{id} [put]
+func (s *Server) UpdatePerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "UpdatePerson", errs.InvalidArgument("invalid person id"))
+		return
+	}
+	var req protos.UpdatePersonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "UpdatePerson", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.Id = int32(id)
+	resp, err := s.Usecase.UpdatePerson(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "UpdatePerson", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeletePerson godoc
+// @Summary      Удалить персону
+// @Description  Удаляет персону по её ID. Если персона указана в титрах фильмов, без force=true возвращается 409. Требуется роль admin.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int   true   "ID персоны"
+// @Param        force  query     bool  false  "Удалить вместе с участием в фильмах"
+// @Success      200    {object}  emptypb.Empty
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Failure      409    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /people/{id} [delete]
+func (s *Server) DeletePerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "DeletePerson", errs.InvalidArgument("invalid person id"))
+		return
+	}
+	force, _ := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	req := &protos.DeletePersonRequest{Id: int32(id), Force: force}
+	if _, err := s.Usecase.DeletePerson(c.Request.Context(), req); err != nil {
+		s.fail(c, "DeletePerson", err)
+		return
+	}
+	c.JSON(http.StatusOK, &emptypb.Empty{})
+}
+
+// ListFilmography godoc
+// @Summary      Фильмография
+// @Description  Возвращает персону и фильмы, в которых она участвовала, от новых к старым.
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID персоны"
+// @Success      200  {object}  __.ListFilmographyResponse
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /people/{id}/movies [get]
+func (s *Server) ListFilmography(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "ListFilmography", errs.InvalidArgument("invalid person id"))
+		return
+	}
+	resp, err := s.Usecase.ListFilmography(c.Request.Context(), &protos.ListFilmographyRequest{PersonId: int32(id)})
+	if err != nil {
+		s.fail(c, "ListFilmography", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// SetMovieCredits godoc
+// @Summary      Заменить титры фильма
+// @Description  Полностью заменяет актёров и съёмочную группу фильма; пустой список удаляет всех. Требуется роль admin.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                        true  "ID фильма"
+// @Param        input  body      __.SetMovieCreditsRequest  true  "Титры"
+// @Success      200    {object}  __.Movie
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/credits [put]
+func (s *Server) SetMovieCredits(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "SetMovieCredits", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.SetMovieCreditsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "SetMovieCredits", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.MovieId = int32(id)
+	resp, err := s.Usecase.SetMovieCredits(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "SetMovieCredits", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// PublishMovie godoc
+// @Summary      Опубликовать фильм
+// @Description  Сразу публикует фильм из черновика, расписания или архива. Тело необязательно: в нём можно задать время снятия с публикации. Требуется роль admin.
+// @Tags         publication
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                     true   "ID фильма"
+// @Param        input  body      __.PublishMovieRequest  false  "Время снятия с публикации"
+// @Success      200    {object}  __.Movie
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Failure      409    {object}  errorResponse  "фильм уже опубликован"
+// @Security     BearerAuth
+// @Router       /movies/{id}/publish [post]
+func (s *Server) PublishMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "PublishMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.PublishMovieRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		s.fail(c, "PublishMovie", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.Id = int32(id)
+	resp, err := s.Usecase.PublishMovie(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "PublishMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ScheduleMovie godoc
+// @Summary      Запланировать публикацию
+// @Description  Планирует публикацию фильма на publish_at; фоновая задача переводит его в published в это время. Требуется роль admin.
+// @Tags         publication
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                      true  "ID фильма"
+// @Param        input  body      __.ScheduleMovieRequest  true  "Время публикации и снятия с публикации"
+// @Success      200    {object}  __.Movie
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Failure      409    {object}  errorResponse  "фильм уже опубликован"
+// @Security     BearerAuth
+// @Router       /movies/{id}/schedule [post]
+func (s *Server) ScheduleMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "ScheduleMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.ScheduleMovieRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "ScheduleMovie", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.Id = int32(id)
+	resp, err := s.Usecase.ScheduleMovie(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "ScheduleMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ArchiveMovie godoc
+// @Summary      Снять фильм с публикации
+// @Description  Переводит опубликованный или запланированный фильм в архив. Требуется роль admin.
+// @Tags         publication
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма"
+// @Success      200  {object}  __.Movie
+// @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Failure      409  {object}  errorResponse  "фильм не опубликован"
+// @Security     BearerAuth
+// @Router       /movies/{id}/archive [post]
+func (s *Server) ArchiveMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "ArchiveMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	resp, err := s.Usecase.ArchiveMovie(c.Request.Context(), &protos.ArchiveMovieRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "ArchiveMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RevertMovieToDraft godoc
+// @Summary      Вернуть фильм в черновики
+// @Description  Возвращает фильм в черновики и сбрасывает расписание публикации. Требуется роль admin.
+// @Tags         publication
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма"
+// @Success      200  {object}  __.Movie
+// @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Failure      409  {object}  errorResponse  "фильм уже черновик"
+// @Security     BearerAuth
+// @Router       /movies/{id}/draft [post]
+func (s *Server) RevertMovieToDraft(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "RevertMovieToDraft", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	resp, err := s.Usecase.RevertMovieToDraft(c.Request.Context(), &protos.RevertMovieToDraftRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "RevertMovieToDraft", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
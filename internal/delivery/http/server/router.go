@@ -1,30 +1,123 @@
 package server
 
 import (
+	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	_ "movieService/docs" // ← swagger.json и docs.go
+	"movieService/internal/delivery/policy"
+	JWT "movieService/pkg/jwt"
 )
 
 func (s *Server) CreateController() {
+	// спан на каждый запрос с W3C traceparent из заголовков; дальше контекст идёт в usecase и pgx.
+	// Recovery — последним, чтобы паника учитывалась в логе и метриках как 500
+	s.serv.Use(
+		otelgin.Middleware(s.cfg.Tracing.ServiceName),
+		s.middleware.RequestID(),
+		s.middleware.AccessLog(),
+		s.middleware.Metrics(),
+		s.middleware.Recovery(),
+	)
+
 	//url := ginSwagger.URL("/docs/swagger.json") // путь до вашего swagger.json
 	s.serv.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	api := s.serv.Group("/api")
+	// пробы оркестратора: liveness не трогает зависимости, readiness проверяет БД
+	s.serv.GET("/healthz", s.Liveness)
+	s.serv.GET("/readyz", s.Readiness)
+	s.serv.GET("/metrics", gin.WrapH(s.metrics.Handler()))
+
+	// токен на публичных маршрутах необязателен, но если он есть — администратор видит и неопубликованные фильмы
+	api := s.serv.Group("/api", s.middleware.OptionalAuth())
 	{
 		api.GET("/movies", s.ListMovies)
+		api.GET("/movies/search", s.SearchMovies)
 		api.GET("/movies/:id", s.GetMovie)
-		api.POST("/movies", s.CreateMovie)
-		api.DELETE("/movies/:id", s.DeleteMovie)
+		api.GET("/movies/:id/translations", s.ListMovieTranslations)
 
 		api.GET("/movies/:id/ratings", s.ListRatings)
 		api.GET("/movies/:id/ratings/:rid", s.GetRating)
-		api.POST("/movies/:id/ratings", s.CreateRating)
-		api.DELETE("/movies/:id/ratings/:rid", s.DeleteRating)
 
 		api.GET("/movies/:id/comments", s.ListComments)
 		api.GET("/movies/:id/comments/:cid", s.GetComment)
-		api.POST("/movies/:id/comments", s.CreateComment)
-		api.DELETE("/movies/:id/comments/:cid", s.DeleteComment)
+
+		api.GET("/genres", s.ListGenres)
+
+		api.GET("/people", s.ListPeople)
+		api.GET("/people/:id", s.GetPerson)
+		api.GET("/people/:id/movies", s.ListFilmography)
+
+		api.GET("/series", s.ListSeries)
+		api.GET("/series/:id", s.GetSeries)
+		api.GET("/series/:id/episodes", s.ListEpisodes)
+		api.GET("/episodes/:id", s.GetEpisode)
+		api.GET("/episodes/:id/next", s.GetNextEpisode)
+	}
+
+	// все изменяющие запросы — только с валидным Bearer-токеном и ролью из policy.Table
+	protected := api.Group("", s.middleware.Auth())
+	{
+		protected.POST("/movies", s.requireRole("CreateMovie"), s.CreateMovie)
+		protected.DELETE("/movies/:id", s.requireRole("DeleteMovie"), s.DeleteMovie)
+		protected.POST("/movies/:id/restore", s.requireRole("RestoreMovie"), s.RestoreMovie)
+		protected.POST("/movies/:id/purge", s.requireRole("PurgeMovie"), s.PurgeMovie)
+		protected.POST("/movies/:id/publish", s.requireRole("PublishMovie"), s.PublishMovie)
+		protected.POST("/movies/:id/schedule", s.requireRole("ScheduleMovie"), s.ScheduleMovie)
+		protected.POST("/movies/:id/archive", s.requireRole("ArchiveMovie"), s.ArchiveMovie)
+		protected.POST("/movies/:id/draft", s.requireRole("RevertMovieToDraft"), s.RevertMovieToDraft)
+		protected.PUT("/movies/:id", s.requireRole("UpdateMovie"), s.UpdateMovie)
+		protected.PATCH("/movies/:id", s.requireRole("PatchMovie"), s.PatchMovie)
+		protected.PUT("/movies/:id/translations/:locale", s.requireRole("SetMovieTranslation"), s.SetMovieTranslation)
+		protected.DELETE("/movies/:id/translations/:locale", s.requireRole("DeleteMovieTranslation"), s.DeleteMovieTranslation)
+		protected.PUT("/movies/:id/certifications", s.requireRole("SetMovieCertifications"), s.SetMovieCertifications)
+
+		protected.POST("/movies/:id/ratings", s.requireRole("CreateRating"), s.CreateRating)
+		protected.GET("/movies/:id/ratings/me", s.requireRole("GetMyRating"), s.GetMyRating)
+		protected.DELETE("/movies/:id/ratings/:rid", s.requireRole("DeleteRating"), s.DeleteRating)
+
+		protected.POST("/movies/:id/comments", s.requireRole("CreateComment"), s.CreateComment)
+		protected.PATCH("/movies/:id/comments/:cid", s.requireRole("EditComment"), s.EditComment)
+		protected.DELETE("/movies/:id/comments/:cid", s.requireRole("DeleteComment"), s.DeleteComment)
+
+		protected.POST("/genres", s.requireRole("CreateGenre"), s.CreateGenre)
+		protected.PUT("/genres/:id", s.requireRole("UpdateGenre"), s.UpdateGenre)
+		protected.DELETE("/genres/:id", s.requireRole("DeleteGenre"), s.DeleteGenre)
+
+		protected.POST("/people", s.requireRole("CreatePerson"), s.CreatePerson)
+		protected.PUT("/people/:id", s.requireRole("UpdatePerson"), s.UpdatePerson)
+		protected.DELETE("/people/:id", s.requireRole("DeletePerson"), s.DeletePerson)
+		protected.PUT("/movies/:id/credits", s.requireRole("SetMovieCredits"), s.SetMovieCredits)
+	}
+
+	// оценки и комментарии сериалов и эпизодов обслуживают те же обработчики, что и у фильмов;
+	// withTarget подсказывает им, чей ID пришёл в :id
+	for _, t := range []struct{ path, kind string }{
+		{"/series/:id", targetSeries},
+		{"/episodes/:id", targetEpisode},
+	} {
+		public := api.Group(t.path, withTarget(t.kind))
+		public.GET("/ratings", s.ListRatings)
+		public.GET("/ratings/:rid", s.GetRating)
+		public.GET("/comments", s.ListComments)
+		public.GET("/comments/:cid", s.GetComment)
+
+		private := protected.Group(t.path, withTarget(t.kind))
+		private.POST("/ratings", s.requireRole("CreateRating"), s.CreateRating)
+		private.GET("/ratings/me", s.requireRole("GetMyRating"), s.GetMyRating)
+		private.DELETE("/ratings/:rid", s.requireRole("DeleteRating"), s.DeleteRating)
+		private.POST("/comments", s.requireRole("CreateComment"), s.CreateComment)
+		private.PATCH("/comments/:cid", s.requireRole("EditComment"), s.EditComment)
+		private.DELETE("/comments/:cid", s.requireRole("DeleteComment"), s.DeleteComment)
+	}
+}
+
+// requireRole возвращает проверку роли для операции по таблице policy.Table.
+func (s *Server) requireRole(operation string) gin.HandlerFunc {
+	role, ok := policy.RequiredRole(operation)
+	if !ok {
+		role = JWT.RoleViewer
 	}
+	return s.middleware.RequireRole(role)
 }
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListSeries godoc
+// @Summary      Список сериалов
+// @Description  Возвращает постраничный список сериалов, отсортированный по названию.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        page     query     int  false  "Номер страницы"        default(1)
+// @Param        per_page query     int  false  "Элементов на страницу" default(10)
+// @Success      200      {object}  __.ListSeriesResponse
+// @Failure      400      {object}  errorResponse
+// @Failure      500      {object}  errorResponse
+// @Router       /series [get]
+func (s *Server) ListSeries(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	per, _ := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	req := &protos.ListSeriesRequest{
+		Page:    int32(page),
+		PerPage: int32(per),
+	}
+	resp, err := s.Usecase.ListSeries(c.Request.Context(), req)
+	if err != nil {
+		s.fail(c, "ListSeries", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetSeries godoc
+// @Summary      Получить сериал
+// @Description  Возвращает сериал по его ID вместе со списком сезонов.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID сериала"
+// @Success      200  {object}  __.Series
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /series/{id} [get]
+func (s *Server) GetSeries(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "GetSeries", errs.InvalidArgument("invalid series id"))
+		return
+	}
+	resp, err := s.Usecase.GetSeries(c.Request.Context(), &protos.GetSeriesRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "GetSeries", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListEpisodes godoc
+// @Summary      Эпизоды сериала
+// @Description  Возвращает эпизоды сериала по порядку сезонов и номеров. С season — только эпизоды этого сезона.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int  true   "ID сериала"
+// @Param        season  query     int  false  "Номер сезона"
+// @Success      200     {object}  __.ListEpisodesResponse
+// @Failure      400     {object}  errorResponse
+// @Failure      404     {object}  errorResponse
+// @Router       /series/{id}/episodes [get]
+func (s *Server) ListEpisodes(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "ListEpisodes", errs.InvalidArgument("invalid series id"))
+		return
+	}
+	season, err := strconv.Atoi(c.DefaultQuery("season", "0"))
+	if err != nil {
+		s.fail(c, "ListEpisodes", errs.InvalidArgument("invalid season"))
+		return
+	}
+	req := &protos.ListEpisodesRequest{
+		SeriesId: int32(id),
+		Season:   int32(season),
+	}
+	resp, err := s.Usecase.ListEpisodes(c.Request.Context(), req)
+	if err != nil {
+		s.fail(c, "ListEpisodes", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetEpisode godoc
+// @Summary      Получить эпизод
+// @Description  Возвращает эпизод по его ID: ссылку на видео, длительность и рейтинг.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID эпизода"
+// @Success      200  {object}  __.Episode
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /episodes/{id} [get]
+func (s *Server) GetEpisode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "GetEpisode", errs.InvalidArgument("invalid episode id"))
+		return
+	}
+	resp, err := s.Usecase.GetEpisode(c.Request.Context(), &protos.GetEpisodeRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "GetEpisode", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetNextEpisode godoc
+// @Summary      Следующий эпизод
+// @Description  Возвращает эпизод, идущий после указанного, с переходом на следующий сезон. У последнего эпизода сериала поле episode пустое.
+// @Tags         series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID текущего эпизода"
+// @Success      200  {object}  __.GetNextEpisodeResponse
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /episodes/{id}/next [get]
+func (s *Server) GetNextEpisode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "GetNextEpisode", errs.InvalidArgument("invalid episode id"))
+		return
+	}
+	resp, err := s.Usecase.GetNextEpisode(c.Request.Context(), &protos.GetNextEpisodeRequest{EpisodeId: int32(id)})
+	if err != nil {
+		s.fail(c, "GetNextEpisode", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -2,69 +2,144 @@ package server
 
 import (
 	"context"
-	_ "fmt"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"io"
 	"movieService/internal/config"
 	"movieService/internal/delivery/http/middleware"
+	"movieService/internal/errs"
+	"movieService/internal/health"
+	"movieService/internal/metrics"
 	"movieService/internal/usecase"
 	protos "movieService/pkg/proto/gen/go"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
 type Server struct {
 	log        *zap.Logger
 	cfg        *config.Config
 	serv       *gin.Engine
+	http       *http.Server
 	Usecase    usecase.InterfaceUsecase
 	middleware *middleware.Middleware
+	shutdowner fx.Shutdowner
+	health     *health.Checker
+	metrics    *metrics.Metrics
+
+	// ready — принимает ли инстанс новый трафик; сбрасывается в начале остановки
+	ready atomic.Bool
 }
 
 var _ usecase.InterfaceUsecase = (*usecase.Usecase)(nil)
 var _ InterfaceServer = (*Server)(nil)
 
-func NewServer(logger *zap.Logger, cfg *config.Config, uc usecase.InterfaceUsecase, middleware *middleware.Middleware) (*Server, error) {
+func NewServer(logger *zap.Logger, cfg *config.Config, uc usecase.InterfaceUsecase, middleware *middleware.Middleware, shutdowner fx.Shutdowner, checker *health.Checker, m *metrics.Metrics) (*Server, error) {
 	return &Server{
 		log:        logger,
 		cfg:        cfg,
-		serv:       gin.Default(),
+		serv:       gin.New(), // логирование и recovery — свои, см. CreateController
 		Usecase:    uc,
 		middleware: middleware,
+		shutdowner: shutdowner,
+		health:     checker,
+		metrics:    m,
 	}, nil
 }
 
+// OnStart занимает порт синхронно, чтобы ошибка (например, порт занят) остановила запуск приложения,
+// и обслуживает запросы в фоне. Если Serve завершится с ошибкой позже, приложение останавливается.
 func (s *Server) OnStart(_ context.Context) error {
 	s.CreateController()
+
+	addr := s.cfg.Server.Host + s.cfg.Server.HTTPPort
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen http %s: %w", addr, err)
+	}
+	s.http = &http.Server{
+		Handler:      s.serv,
+		ReadTimeout:  s.cfg.Server.ReadTimeout,
+		WriteTimeout: s.cfg.Server.WriteTimeout,
+		IdleTimeout:  s.cfg.Server.IdleTimeout,
+	}
+	s.ready.Store(true)
+
 	go func() {
-		s.log.Debug("server started")
-		if err := s.serv.Run(s.cfg.Server.Host + ":" + s.cfg.Server.Port); err != nil {
-			s.log.Error("failed to server: " + err.Error())
+		s.log.Debug("server started", zap.String("addr", addr))
+		if err := s.http.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error("failed to serve http", zap.Error(err))
+			s.ready.Store(false)
+			if err := s.shutdowner.Shutdown(fx.ExitCode(1)); err != nil {
+				s.log.Error("failed to shutdown app", zap.Error(err))
+			}
 		}
-		return
 	}()
 	return nil
 }
 
-func (s *Server) OnStop(_ context.Context) error {
+// OnStop переводит инстанс в not-ready, ждёт ShutdownDelay и дожидается завершения
+// текущих запросов. Если ctx истёк раньше, оставшиеся соединения закрываются принудительно.
+func (s *Server) OnStop(ctx context.Context) error {
 	s.log.Debug("stop server")
+	s.ready.Store(false)
+	if s.http == nil {
+		return nil
+	}
+
+	select {
+	case <-time.After(s.cfg.Server.ShutdownDelay):
+	case <-ctx.Done():
+	}
+
+	if err := s.http.Shutdown(ctx); err != nil {
+		s.log.Warn("http server shutdown timed out, closing connections", zap.Error(err))
+		return s.http.Close()
+	}
 	return nil
 }
 
+// Ready сообщает, принимает ли сервер новый трафик.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
+
 // ListMovies godoc
 // @Summary      Список фильмов
-// @Description  Возвращает постраничный список фильмов с опциональным фильтром по жанрам.
+// @Description  Возвращает постраничный список фильмов с опциональными фильтрами по жанрам и участнику и сортировкой.
+// @Description  Названия и описания переводятся по заголовку Accept-Language; без подходящего перевода — на языке по умолчанию.
+// @Description  Без токена администратора возвращаются только опубликованные фильмы.
+// @Description  Для токена детского профиля (claim max_age) возрастной фильтр применяется всегда и не ослабляется параметрами.
 // @Tags         movies
 // @Accept       json
 // @Produce      json
-// @Param        page     query     int     false  "Номер страницы"        default(1)
-// @Param        per_page query     int     false  "Элементов на страницу" default(10)
-// @Param        genres   query     []int   false  "Фильтр по жанрам"     collectionFormat(csv)
-// @Success      200      {object}  __.ListMoviesResponse
-// @Failure 	 400 {object} errorResponse
-// @Failure      500      {object}  errorResponse
+// @Param        page       query     int     false  "Номер страницы"        default(1)
+// @Param        per_page   query     int     false  "Элементов на страницу" default(10)
+// @Param        genres     query     []int   false  "Фильтр по жанрам"     collectionFormat(csv)
+// @Param        sort_by    query     string  false  "Поле сортировки"      Enums(id, title, release_date, duration_min, created_at, rating)
+// @Param        sort_order query     string  false  "Направление"          Enums(asc, desc)
+// @Param        page_token query     string  false  "Курсор next_page_token; если задан, page игнорируется"
+// @Param        person_id  query     int     false  "Только фильмы с участием персоны"
+// @Param        status     query     string  false  "Состояние публикации, только для admin" Enums(draft, scheduled, published, archived)
+// @Param        max_age    query     int     false  "Только фильмы с рейтингом страны country не старше этого возраста"
+// @Param        certifications query []string false "Только фильмы с одним из рейтингов страны country" collectionFormat(csv)
+// @Param        country    query     string  false  "Страна рейтингов, ISO 3166-1 alpha-2; по умолчанию из конфига"
+// @Param        Accept-Language header string false  "Предпочитаемые языки, например en-US, en;q=0.8"
+// @Success      200        {object}  __.ListMoviesResponse
+// @Failure      400        {object}  errorResponse
+// @Failure      401        {object}  errorResponse
+// @Failure      403        {object}  errorResponse  "фильтр status без роли admin"
+// @Failure      500        {object}  errorResponse
 // @Router       /movies [get]
 func (s *Server) ListMovies(c *gin.Context) {
 	// parse query params
@@ -78,16 +153,85 @@ func (s *Server) ListMovies(c *gin.Context) {
 			}
 		}
 	}
+	personID, _ := strconv.Atoi(c.Query("person_id"))
+	maxAge, certifications, err := ageQuery(c)
+	if err != nil {
+		s.fail(c, "ListMovies", err)
+		return
+	}
 
 	req := &protos.ListMoviesRequest{
+		Page:      int32(page),
+		PerPage:   int32(per),
+		GenreIds:  genres,
+		SortBy:    c.Query("sort_by"),
+		SortOrder: c.Query("sort_order"),
+		PageToken: c.Query("page_token"),
+		PersonId:  int32(personID),
+		Locale:    c.GetHeader("Accept-Language"),
+		Status:    c.Query("status"),
+
+		MaxAge:         maxAge,
+		Certifications: certifications,
+		Country:        c.Query("country"),
+	}
+	resp, err := s.Usecase.ListMovies(c.Request.Context(), req)
+	if err != nil {
+		s.fail(c, "ListMovies", err)
+		return
+	}
+	c.Header("Vary", "Accept-Language")
+	c.JSON(http.StatusOK, resp)
+}
+
+// SearchMovies godoc
+// @Summary      Поиск фильмов
+// @Description  Полнотекстовый поиск по названию и описанию: каждое слово ищется по префиксу, результаты отсортированы по релевантности.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        q        query     string  true   "Поисковая строка"
+// @Param        page     query     int     false  "Номер страницы"        default(1)
+// @Param        per_page query     int     false  "Элементов на страницу" default(10)
+// @Param        genres   query     []int   false  "Фильтр по жанрам"     collectionFormat(csv)
+// @Param        max_age  query     int     false  "Только фильмы с рейтингом страны country не старше этого возраста"
+// @Param        certifications query []string false "Только фильмы с одним из рейтингов страны country" collectionFormat(csv)
+// @Param        country  query     string  false  "Страна рейтингов, ISO 3166-1 alpha-2; по умолчанию из конфига"
+// @Success      200      {object}  __.SearchMoviesResponse
+// @Failure      400      {object}  errorResponse
+// @Failure      500      {object}  errorResponse
+// @Router       /movies/search [get]
+func (s *Server) SearchMovies(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	per, _ := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	genres := make([]int32, 0)
+	if gs := c.Query("genres"); gs != "" {
+		for _, part := range strings.Split(gs, ",") {
+			if id, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+				genres = append(genres, int32(id))
+			}
+		}
+	}
+
+	maxAge, certifications, err := ageQuery(c)
+	if err != nil {
+		s.fail(c, "SearchMovies", err)
+		return
+	}
+
+	req := &protos.SearchMoviesRequest{
+		Query:    c.Query("q"),
 		Page:     int32(page),
 		PerPage:  int32(per),
 		GenreIds: genres,
+
+		MaxAge:         maxAge,
+		Certifications: certifications,
+		Country:        c.Query("country"),
 	}
-	resp, err := s.Usecase.ListMovies(c.Request.Context(), req)
+	resp, err := s.Usecase.SearchMovies(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("ListMovies error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "SearchMovies", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -95,11 +239,13 @@ func (s *Server) ListMovies(c *gin.Context) {
 
 // GetMovie godoc
 // @Summary      Получить фильм
-// @Description  Возвращает подробную информацию о фильме по его ID.
+// @Description  Возвращает подробную информацию о фильме по его ID, включая актёров и съёмочную группу.
+// @Description  Название и описание переводятся по заголовку Accept-Language, выбранный язык — в Content-Language.
 // @Tags         movies
 // @Accept       json
 // @Produce      json
 // @Param        id   path      int  true  "ID фильма"
+// @Param        Accept-Language header string false  "Предпочитаемые языки, например en-US, en;q=0.8"
 // @Success      200  {object}  __.Movie
 // @Failure      400  {object}  errorResponse
 // @Failure      404  {object}  errorResponse
@@ -107,41 +253,43 @@ func (s *Server) ListMovies(c *gin.Context) {
 func (s *Server) GetMovie(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid movie id"})
+		s.fail(c, "GetMovie", errs.InvalidArgument("invalid movie id"))
 		return
 	}
-	req := &protos.GetMovieRequest{Id: int32(id)}
+	req := &protos.GetMovieRequest{Id: int32(id), Locale: c.GetHeader("Accept-Language")}
 	resp, err := s.Usecase.GetMovie(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("GetMovie error", zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		s.fail(c, "GetMovie", err)
 		return
 	}
+	c.Header("Vary", "Accept-Language")
+	c.Header("Content-Language", resp.GetLocale())
 	c.JSON(http.StatusOK, resp)
 }
 
 // CreateMovie godoc
 // @Summary      Создать фильм
-// @Description  Создаёт новый фильм в системе.
+// @Description  Создаёт новый фильм в системе. Требуется роль admin.
 // @Tags         movies
 // @Accept       json
 // @Produce      json
 // @Param        input  body      __.CreateMovieRequest  true  "Данные фильма"
 // @Success      201    {object}  __.CreateMovieResponse
 // @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Security     BearerAuth
 // @Router       /movies [post]
 func (s *Server) CreateMovie(c *gin.Context) {
 	var req protos.CreateMovieRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		s.log.Error("CreateMovie: invalid payload", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		s.fail(c, "CreateMovie", errs.InvalidArgument("invalid payload: %v", err))
 		return
 	}
 
 	resp, err := s.Usecase.CreateMovie(c.Request.Context(), &req)
 	if err != nil {
-		s.log.Error("CreateMovie error", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		s.fail(c, "CreateMovie", err)
 		return
 	}
 	c.JSON(http.StatusCreated, resp)
@@ -149,56 +297,217 @@ func (s *Server) CreateMovie(c *gin.Context) {
 
 // DeleteMovie godoc
 // @Summary      Удалить фильм
-// @Description  Удаляет фильм по его ID.
+// @Description  Мягко удаляет фильм по его ID: фильм пропадает из выдачи, оценки и комментарии сохраняются. Требуется роль admin.
 // @Tags         movies
 // @Accept       json
 // @Produce      json
 // @Param        id   path      int  true  "ID фильма"
 // @Success      200  {object}  emptypb.Empty
 // @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
 // @Failure      404  {object}  errorResponse
+// @Security     BearerAuth
 // @Router       /movies/{id} [delete]
 func (s *Server) DeleteMovie(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid movie id"})
+		s.fail(c, "DeleteMovie", errs.InvalidArgument("invalid movie id"))
 		return
 	}
 	req := &protos.DeleteMovieRequest{Id: int32(id)}
 	if _, err := s.Usecase.DeleteMovie(c.Request.Context(), req); err != nil {
-		s.log.Error("DeleteMovie error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "DeleteMovie", err)
 		return
 	}
 	c.JSON(http.StatusOK, &emptypb.Empty{})
 }
 
+// RestoreMovie godoc
+// @Summary      Восстановить фильм
+// @Description  Восстанавливает мягко удалённый фильм. Требуется роль admin.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма"
+// @Success      200  {object}  __.Movie
+// @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/restore [post]
+func (s *Server) RestoreMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "RestoreMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	resp, err := s.Usecase.RestoreMovie(c.Request.Context(), &protos.RestoreMovieRequest{Id: int32(id)})
+	if err != nil {
+		s.fail(c, "RestoreMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// PurgeMovie godoc
+// @Summary      Окончательно удалить фильм
+// @Description  Безвозвратно удаляет мягко удалённый фильм вместе с жанрами, оценками и комментариями. Требуется роль admin.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма"
+// @Success      200  {object}  emptypb.Empty
+// @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Failure      409  {object}  errorResponse  "фильм не удалён"
+// @Security     BearerAuth
+// @Router       /movies/{id}/purge [post]
+func (s *Server) PurgeMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "PurgeMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	if _, err := s.Usecase.PurgeMovie(c.Request.Context(), &protos.PurgeMovieRequest{Id: int32(id)}); err != nil {
+		s.fail(c, "PurgeMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, &emptypb.Empty{})
+}
+
+// UpdateMovie godoc
+// @Summary      Обновить фильм
+// @Description  Полностью заменяет данные фильма и список его жанров. Требуется роль admin.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                    true  "ID фильма"
+// @Param        input  body      __.UpdateMovieRequest  true  "Новые данные фильма"
+// @Success      200    {object}  __.UpdateMovieResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id} [put]
+func (s *Server) UpdateMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "UpdateMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.UpdateMovieRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "UpdateMovie", errs.InvalidArgument("invalid payload: %v", err))
+		return
+	}
+	req.Id = int32(id)
+
+	resp, err := s.Usecase.UpdateMovie(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "UpdateMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// PatchMovie godoc
+// @Summary      Частично обновить фильм
+// @Description  Обновляет только переданные поля фильма. Если update_mask не указан, маска строится по ключам JSON-тела. Требуется роль admin.
+// @Tags         movies
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                   true  "ID фильма"
+// @Param        input  body      __.PatchMovieRequest  true  "Изменяемые поля фильма"
+// @Success      200    {object}  __.UpdateMovieResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id} [patch]
+func (s *Server) PatchMovie(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "PatchMovie", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		s.fail(c, "PatchMovie", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	var req protos.PatchMovieRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		s.fail(c, "PatchMovie", errs.InvalidArgument("invalid payload: %v", err))
+		return
+	}
+	req.Id = int32(id)
+
+	// маска не передана явно — берём ключи, присутствующие в теле запроса
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			s.fail(c, "PatchMovie", errs.InvalidArgument("invalid payload: %v", err))
+			return
+		}
+		paths := make([]string, 0, len(fields))
+		for k := range fields {
+			if k == "id" || k == "update_mask" {
+				continue
+			}
+			paths = append(paths, k)
+		}
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+	}
+
+	resp, err := s.Usecase.PatchMovie(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "PatchMovie", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // ListRatings godoc
 // @Summary      Список оценок
-// @Description  Возвращает постраничный список оценок для указанного фильма.
+// @Description  Возвращает постраничный список оценок фильма, сериала или эпизода.
 // @Tags         ratings
 // @Accept       json
 // @Produce      json
-// @Param        id       path      int  true  "ID фильма"
+// @Param        id       path      int  true  "ID фильма, сериала или эпизода"
 // @Param        page     query     int  false "Номер страницы"        default(1)
 // @Param        per_page query     int  false "Элементов на страницу" default(10)
+// @Param        page_token query  string false "Курсор next_page_token; если задан, page игнорируется"
 // @Success      200      {object}  __.ListRatingsResponse
 // @Failure      400      {object}  errorResponse
 // @Failure      500      {object}  errorResponse
 // @Router       /movies/{id}/ratings [get]
+// @Router       /series/{id}/ratings [get]
+// @Router       /episodes/{id}/ratings [get]
 func (s *Server) ListRatings(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "ListRatings", err)
+		return
+	}
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	per, _ := strconv.Atoi(c.DefaultQuery("per_page", "10"))
 	req := &protos.ListRatingsRequest{
-		MovieId: int32(mid),
-		Page:    int32(page),
-		PerPage: int32(per),
+		MovieId:   t.movieID,
+		SeriesId:  t.seriesID,
+		EpisodeId: t.episodeID,
+		Page:      int32(page),
+		PerPage:   int32(per),
+		PageToken: c.Query("page_token"),
 	}
 	resp, err := s.Usecase.ListRatings(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("ListRatings error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "ListRatings", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -206,81 +515,141 @@ func (s *Server) ListRatings(c *gin.Context) {
 
 // GetRating godoc
 // @Summary      Получить оценку
-// @Description  Возвращает конкретную оценку по ID фильма и ID оценки.
+// @Description  Возвращает конкретную оценку по ID фильма (сериала или эпизода) и ID оценки.
 // @Tags         ratings
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "ID фильма"
+// @Param        id   path      int  true  "ID фильма, сериала или эпизода"
 // @Param        rid  path      int  true  "ID оценки"
 // @Success      200  {object}  __.Rating
 // @Failure      400  {object}  errorResponse
 // @Failure      404  {object}  errorResponse
 // @Router       /movies/{id}/ratings/{rid} [get]
+// @Router       /series/{id}/ratings/{rid} [get]
+// @Router       /episodes/{id}/ratings/{rid} [get]
 func (s *Server) GetRating(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "GetRating", err)
+		return
+	}
 	rid, _ := strconv.Atoi(c.Param("rid"))
 	req := &protos.GetRatingRequest{
-		MovieId:  int32(mid),
-		RatingId: int32(rid),
+		MovieId:   t.movieID,
+		SeriesId:  t.seriesID,
+		EpisodeId: t.episodeID,
+		RatingId:  int32(rid),
 	}
 	resp, err := s.Usecase.GetRating(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("GetRating error", zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		s.fail(c, "GetRating", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
 }
 
 // CreateRating godoc
-// @Summary      Создать оценку
-// @Description  Создаёт новую оценку для фильма.
+// @Summary      Поставить оценку
+// @Description  Создаёт оценку фильма, сериала или эпизода от имени пользователя из токена. Повторная оценка того же объекта обновляет score.
 // @Tags         ratings
 // @Accept       json
 // @Produce      json
+// @Param        id     path      int                     true  "ID фильма, сериала или эпизода"
 // @Param        input  body      __.CreateRatingRequest  true  "Данные оценки"
+// @Success      200    {object}  __.CreateRatingResponse
 // @Success      201    {object}  __.CreateRatingResponse
 // @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Security     BearerAuth
 // @Router       /movies/{id}/ratings [post]
+// @Router       /series/{id}/ratings [post]
+// @Router       /episodes/{id}/ratings [post]
 func (s *Server) CreateRating(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "CreateRating", err)
+		return
+	}
 	var req protos.CreateRatingRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		s.fail(c, "CreateRating", errs.InvalidArgument("invalid payload"))
 		return
 	}
-	req.MovieId = int32(mid)
+	req.MovieId, req.SeriesId, req.EpisodeId = t.movieID, t.seriesID, t.episodeID
 	resp, err := s.Usecase.CreateRating(c.Request.Context(), &req)
 	if err != nil {
-		s.log.Error("CreateRating error", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		s.fail(c, "CreateRating", err)
+		return
+	}
+	if !resp.GetCreated() {
+		c.JSON(http.StatusOK, resp)
 		return
 	}
 	c.JSON(http.StatusCreated, resp)
 }
 
+// GetMyRating godoc
+// @Summary      Моя оценка
+// @Description  Возвращает оценку фильма, сериала или эпизода, поставленную пользователем из токена.
+// @Tags         ratings
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма, сериала или эпизода"
+// @Success      200  {object}  __.Rating
+// @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/ratings/me [get]
+// @Router       /series/{id}/ratings/me [get]
+// @Router       /episodes/{id}/ratings/me [get]
+func (s *Server) GetMyRating(c *gin.Context) {
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "GetMyRating", err)
+		return
+	}
+	req := &protos.GetMyRatingRequest{MovieId: t.movieID, SeriesId: t.seriesID, EpisodeId: t.episodeID}
+	resp, err := s.Usecase.GetMyRating(c.Request.Context(), req)
+	if err != nil {
+		s.fail(c, "GetMyRating", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // DeleteRating godoc
 // @Summary      Удалить оценку
-// @Description  Удаляет оценку по ID фильма и ID оценки.
+// @Description  Удаляет оценку по ID фильма (сериала или эпизода) и ID оценки. Удалить оценку может только её автор.
 // @Tags         ratings
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "ID фильма"
+// @Param        id   path      int  true  "ID фильма, сериала или эпизода"
 // @Param        rid  path      int  true  "ID оценки"
 // @Success      200  {object}  emptypb.Empty
 // @Failure      400  {object}  errorResponse
+// @Failure      401  {object}  errorResponse
+// @Failure      403  {object}  errorResponse
 // @Failure      404  {object}  errorResponse
+// @Security     BearerAuth
 // @Router       /movies/{id}/ratings/{rid} [delete]
+// @Router       /series/{id}/ratings/{rid} [delete]
+// @Router       /episodes/{id}/ratings/{rid} [delete]
 func (s *Server) DeleteRating(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "DeleteRating", err)
+		return
+	}
 	rid, _ := strconv.Atoi(c.Param("rid"))
 	req := &protos.DeleteRatingRequest{
-		MovieId:  int32(mid),
-		RatingId: int32(rid),
+		MovieId:   t.movieID,
+		SeriesId:  t.seriesID,
+		EpisodeId: t.episodeID,
+		RatingId:  int32(rid),
 	}
 	if _, err := s.Usecase.DeleteRating(c.Request.Context(), req); err != nil {
-		s.log.Error("DeleteRating error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "DeleteRating", err)
 		return
 	}
 	c.JSON(http.StatusOK, &emptypb.Empty{})
@@ -288,30 +657,46 @@ func (s *Server) DeleteRating(c *gin.Context) {
 
 // ListComments godoc
 // @Summary      Список комментариев
-// @Description  Возвращает постраничный список комментариев к фильму.
+// @Description  Возвращает постраничный список корневых комментариев к фильму, сериалу или эпизоду или ответов на комментарий parent_id.
 // @Tags         comments
 // @Accept       json
 // @Produce      json
-// @Param        id       path      int  true  "ID фильма"
+// @Param        id       path      int  true  "ID фильма, сериала или эпизода"
 // @Param        page     query     int  false "Номер страницы"        default(1)
 // @Param        per_page query     int  false "Элементов на страницу" default(10)
+// @Param        page_token query  string false "Курсор next_page_token; если задан, page игнорируется"
+// @Param        parent_id    query  int   false "ID комментария, ответы на который нужно вернуть; 0 — корневые"
+// @Param        with_replies query  bool  false "Вложить в каждый комментарий всю ветку ответов"
 // @Success      200      {object}  __.ListCommentsResponse
 // @Failure      400      {object}  errorResponse
+// @Failure      404      {object}  errorResponse
 // @Failure      500      {object}  errorResponse
 // @Router       /movies/{id}/comments [get]
+// @Router       /series/{id}/comments [get]
+// @Router       /episodes/{id}/comments [get]
 func (s *Server) ListComments(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "ListComments", err)
+		return
+	}
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	per, _ := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	parentID, _ := strconv.Atoi(c.Query("parent_id"))
+	withReplies, _ := strconv.ParseBool(c.Query("with_replies"))
 	req := &protos.ListCommentsRequest{
-		MovieId: int32(mid),
-		Page:    int32(page),
-		PerPage: int32(per),
+		MovieId:     t.movieID,
+		SeriesId:    t.seriesID,
+		EpisodeId:   t.episodeID,
+		Page:        int32(page),
+		PerPage:     int32(per),
+		PageToken:   c.Query("page_token"),
+		ParentId:    int32(parentID),
+		WithReplies: withReplies,
 	}
 	resp, err := s.Usecase.ListComments(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("ListComments error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "ListComments", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -319,27 +704,34 @@ func (s *Server) ListComments(c *gin.Context) {
 
 // GetComment godoc
 // @Summary      Получить комментарий
-// @Description  Возвращает конкретный комментарий по ID фильма и ID комментария.
+// @Description  Возвращает конкретный комментарий по ID фильма (сериала или эпизода) и ID комментария.
 // @Tags         comments
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "ID фильма"
+// @Param        id   path      int  true  "ID фильма, сериала или эпизода"
 // @Param        cid  path      int  true  "ID комментария"
 // @Success      200  {object}  __.Comment
 // @Failure      400  {object}  errorResponse
 // @Failure      404  {object}  errorResponse
 // @Router       /movies/{id}/comments/{cid} [get]
+// @Router       /series/{id}/comments/{cid} [get]
+// @Router       /episodes/{id}/comments/{cid} [get]
 func (s *Server) GetComment(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "GetComment", err)
+		return
+	}
 	cid, _ := strconv.Atoi(c.Param("cid"))
 	req := &protos.GetCommentRequest{
-		MovieId:   int32(mid),
+		MovieId:   t.movieID,
+		SeriesId:  t.seriesID,
+		EpisodeId: t.episodeID,
 		CommentId: int32(cid),
 	}
 	resp, err := s.Usecase.GetComment(c.Request.Context(), req)
 	if err != nil {
-		s.log.Error("GetComment error", zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		s.fail(c, "GetComment", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -347,53 +739,222 @@ func (s *Server) GetComment(c *gin.Context) {
 
 // CreateComment godoc
 // @Summary      Создать комментарий
-// @Description  Создаёт новый комментарий к фильму.
+// @Description  Создаёт новый комментарий к фильму, сериалу или эпизоду от имени пользователя из токена. С parent_id — ответ на комментарий того же объекта.
 // @Tags         comments
 // @Accept       json
 // @Produce      json
+// @Param        id     path      int                      true  "ID фильма, сериала или эпизода"
 // @Param        input  body      __.CreateCommentRequest  true  "Данные комментария"
 // @Success      201    {object}  __.CreateCommentResponse
 // @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Security     BearerAuth
 // @Router       /movies/{id}/comments [post]
+// @Router       /series/{id}/comments [post]
+// @Router       /episodes/{id}/comments [post]
 func (s *Server) CreateComment(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "CreateComment", err)
+		return
+	}
 	var req protos.CreateCommentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		s.fail(c, "CreateComment", errs.InvalidArgument("invalid payload"))
 		return
 	}
-	req.MovieId = int32(mid)
+	req.MovieId, req.SeriesId, req.EpisodeId = t.movieID, t.seriesID, t.episodeID
 	resp, err := s.Usecase.CreateComment(c.Request.Context(), &req)
 	if err != nil {
-		s.log.Error("CreateComment error", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		s.fail(c, "CreateComment", err)
 		return
 	}
 	c.JSON(http.StatusCreated, resp)
 }
 
+// EditComment godoc
+// @Summary      Редактировать комментарий
+// @Description  Заменяет текст комментария и помечает его как отредактированный. Редактировать может только автор.
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                     true  "ID фильма, сериала или эпизода"
+// @Param        cid    path      int                     true  "ID комментария"
+// @Param        input  body      __.EditCommentRequest  true  "Новый текст комментария"
+// @Success      200    {object}  __.Comment
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/comments/{cid} [patch]
+// @Router       /series/{id}/comments/{cid} [patch]
+// @Router       /episodes/{id}/comments/{cid} [patch]
+func (s *Server) EditComment(c *gin.Context) {
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "EditComment", err)
+		return
+	}
+	cid, _ := strconv.Atoi(c.Param("cid"))
+	var req protos.EditCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "EditComment", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.MovieId, req.SeriesId, req.EpisodeId = t.movieID, t.seriesID, t.episodeID
+	req.CommentId = int32(cid)
+	resp, err := s.Usecase.EditComment(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "EditComment", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // DeleteComment godoc
 // @Summary      Удалить комментарий
-// @Description Удаляет комментарий по ID фильма и ID комментария.
+// @Description Удаляет комментарий по ID фильма (сериала или эпизода) и ID комментария вместе со всеми ответами. Чужой комментарий может удалить только модератор.
 // @Tags        comments
 // @Accept      json
 // @Produce     json
-// @Param       id   path      int  true  "ID фильма"
+// @Param       id   path      int  true  "ID фильма, сериала или эпизода"
 // @Param       cid  path      int  true  "ID комментария"
 // @Success     200  {object}  emptypb.Empty
 // @Failure     400  {object}  errorResponse
+// @Failure     401  {object}  errorResponse
+// @Failure     403  {object}  errorResponse
 // @Failure     404  {object}  errorResponse
+// @Security    BearerAuth
 // @Router      /movies/{id}/comments/{cid} [delete]
+// @Router      /series/{id}/comments/{cid} [delete]
+// @Router      /episodes/{id}/comments/{cid} [delete]
 func (s *Server) DeleteComment(c *gin.Context) {
-	mid, _ := strconv.Atoi(c.Param("id"))
+	t, err := targetParam(c)
+	if err != nil {
+		s.fail(c, "DeleteComment", err)
+		return
+	}
 	cid, _ := strconv.Atoi(c.Param("cid"))
 	req := &protos.DeleteCommentRequest{
-		MovieId:   int32(mid),
+		MovieId:   t.movieID,
+		SeriesId:  t.seriesID,
+		EpisodeId: t.episodeID,
 		CommentId: int32(cid),
 	}
 	if _, err := s.Usecase.DeleteComment(c.Request.Context(), req); err != nil {
-		s.log.Error("DeleteComment error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		s.fail(c, "DeleteComment", err)
+		return
+	}
+	c.JSON(http.StatusOK, &emptypb.Empty{})
+}
+
+// ListGenres godoc
+// @Summary      Список жанров
+// @Description  Возвращает все жанры с количеством фильмов в каждом.
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  __.ListGenresResponse
+// @Failure      500  {object}  errorResponse
+// @Router       /genres [get]
+func (s *Server) ListGenres(c *gin.Context) {
+	resp, err := s.Usecase.ListGenres(c.Request.Context(), &protos.ListGenresRequest{})
+	if err != nil {
+		s.fail(c, "ListGenres", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateGenre godoc
+// @Summary      Создать жанр
+// @Description  Создаёт новый жанр. Требуется роль admin.
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Param        input  body      __.CreateGenreRequest  true  "Данные жанра"
+// @Success      201    {object}  __.CreateGenreResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /genres [post]
+func (s *Server) CreateGenre(c *gin.Context) {
+	var req protos.CreateGenreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "CreateGenre", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	resp, err := s.Usecase.CreateGenre(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "CreateGenre", err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// UpdateGenre godoc
+// @Summary      Переименовать жанр
+// @Description  Меняет название жанра по его ID. Требуется роль admin.
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                    true  "ID жанра"
+// @Param        input  body      __.UpdateGenreRequest  true  "Новое название"
+// @Success      200    {object}  __.UpdateGenreResponse
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /genres/{id} [put]
+func (s *Server) UpdateGenre(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "UpdateGenre", errs.InvalidArgument("invalid genre id"))
+		return
+	}
+	var req protos.UpdateGenreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "UpdateGenre", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.Id = int32(id)
+	resp, err := s.Usecase.UpdateGenre(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "UpdateGenre", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteGenre godoc
+// @Summary      Удалить жанр
+// @Description  Удаляет жанр по его ID. Если жанр привязан к фильмам, без force=true возвращается 409. Требуется роль admin.
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int   true   "ID жанра"
+// @Param        force  query     bool  false  "Удалить вместе с привязками к фильмам"
+// @Success      200    {object}  emptypb.Empty
+// @Failure      400    {object}  errorResponse
+// @Failure      401    {object}  errorResponse
+// @Failure      403    {object}  errorResponse
+// @Failure      404    {object}  errorResponse
+// @Failure      409    {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /genres/{id} [delete]
+func (s *Server) DeleteGenre(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "DeleteGenre", errs.InvalidArgument("invalid genre id"))
+		return
+	}
+	force, _ := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	req := &protos.DeleteGenreRequest{Id: int32(id), Force: force}
+	if _, err := s.Usecase.DeleteGenre(c.Request.Context(), req); err != nil {
+		s.fail(c, "DeleteGenre", err)
 		return
 	}
 	c.JSON(http.StatusOK, &emptypb.Empty{})
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"movieService/internal/errs"
+)
+
+// Типы объектов, к которым относятся маршруты оценок и комментариев.
+const (
+	targetMovie   = "movie"
+	targetSeries  = "series"
+	targetEpisode = "episode"
+
+	targetKey = "target"
+)
+
+// target — объект оценки или комментария из пути запроса; задано ровно одно поле.
+type target struct {
+	movieID   int32
+	seriesID  int32
+	episodeID int32
+}
+
+// withTarget помечает маршруты типом объекта, ID которого передаётся в :id.
+func withTarget(kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(targetKey, kind)
+		c.Next()
+	}
+}
+
+// targetParam читает объект оценки или комментария из :id; без withTarget это фильм.
+func targetParam(c *gin.Context) (target, error) {
+	kind := c.GetString(targetKey)
+	if kind == "" {
+		kind = targetMovie
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return target{}, errs.InvalidArgument("invalid %s id", kind)
+	}
+	switch kind {
+	case targetSeries:
+		return target{seriesID: int32(id)}, nil
+	case targetEpisode:
+		return target{episodeID: int32(id)}, nil
+	default:
+		return target{movieID: int32(id)}, nil
+	}
+}
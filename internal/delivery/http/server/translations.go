@@ -0,0 +1,103 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListMovieTranslations godoc
+// @Summary      Переводы фильма
+// @Description  Возвращает все переводы названия и описания фильма по возрастанию локали.
+// @Tags         translations
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID фильма"
+// @Success      200  {object}  __.ListMovieTranslationsResponse
+// @Failure      400  {object}  errorResponse
+// @Failure      404  {object}  errorResponse
+// @Router       /movies/{id}/translations [get]
+func (s *Server) ListMovieTranslations(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "ListMovieTranslations", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	resp, err := s.Usecase.ListMovieTranslations(c.Request.Context(), &protos.ListMovieTranslationsRequest{MovieId: int32(id)})
+	if err != nil {
+		s.fail(c, "ListMovieTranslations", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// SetMovieTranslation godoc
+// @Summary      Сохранить перевод фильма
+// @Description  Создаёт или заменяет перевод названия и описания фильма на локаль из пути. Требуется роль admin.
+// @Tags         translations
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                           true  "ID фильма"
+// @Param        locale  path      string                        true  "Языковой тег, например en или pt-BR"
+// @Param        input   body      __.SetMovieTranslationRequest  true  "Название и описание"
+// @Success      200     {object}  __.MovieTranslation
+// @Failure      400     {object}  errorResponse
+// @Failure      401     {object}  errorResponse
+// @Failure      403     {object}  errorResponse
+// @Failure      404     {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/translations/{locale} [put]
+func (s *Server) SetMovieTranslation(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "SetMovieTranslation", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	var req protos.SetMovieTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.fail(c, "SetMovieTranslation", errs.InvalidArgument("invalid payload"))
+		return
+	}
+	req.MovieId = int32(id)
+	req.Locale = c.Param("locale")
+	resp, err := s.Usecase.SetMovieTranslation(c.Request.Context(), &req)
+	if err != nil {
+		s.fail(c, "SetMovieTranslation", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteMovieTranslation godoc
+// @Summary      Удалить перевод фильма
+// @Description  Удаляет перевод фильма на локаль из пути. Требуется роль admin.
+// @Tags         translations
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true  "ID фильма"
+// @Param        locale  path      string  true  "Языковой тег"
+// @Success      200     {object}  emptypb.Empty
+// @Failure      400     {object}  errorResponse
+// @Failure      401     {object}  errorResponse
+// @Failure      403     {object}  errorResponse
+// @Failure      404     {object}  errorResponse
+// @Security     BearerAuth
+// @Router       /movies/{id}/translations/{locale} [delete]
+func (s *Server) DeleteMovieTranslation(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		s.fail(c, "DeleteMovieTranslation", errs.InvalidArgument("invalid movie id"))
+		return
+	}
+	req := &protos.DeleteMovieTranslationRequest{MovieId: int32(id), Locale: c.Param("locale")}
+	if _, err := s.Usecase.DeleteMovieTranslation(c.Request.Context(), req); err != nil {
+		s.fail(c, "DeleteMovieTranslation", err)
+		return
+	}
+	c.JSON(http.StatusOK, &emptypb.Empty{})
+}
@@ -0,0 +1,53 @@
+package policy
+
+import (
+	JWT "movieService/pkg/jwt"
+)
+
+// Table — минимальная роль для каждой защищённой операции API.
+// Ключ совпадает с именем RPC в MovieService и с именем HTTP-обработчика.
+// Операций, которых нет в таблице, аутентификация не требуется.
+var Table = map[string]string{
+	// управление каталогом — только администраторы
+	"CreateMovie": JWT.RoleAdmin,
+	"UpdateMovie": JWT.RoleAdmin,
+	"PatchMovie":  JWT.RoleAdmin,
+	"DeleteMovie": JWT.RoleAdmin,
+	// восстановление и окончательное удаление мягко удалённых фильмов
+	"RestoreMovie": JWT.RoleAdmin,
+	"PurgeMovie":   JWT.RoleAdmin,
+	// публикация: черновики, расписание и архив
+	"PublishMovie":       JWT.RoleAdmin,
+	"ScheduleMovie":      JWT.RoleAdmin,
+	"ArchiveMovie":       JWT.RoleAdmin,
+	"RevertMovieToDraft": JWT.RoleAdmin,
+
+	"CreateGenre": JWT.RoleAdmin,
+	"UpdateGenre": JWT.RoleAdmin,
+	"DeleteGenre": JWT.RoleAdmin,
+	// персоны и титры фильмов
+	"CreatePerson":    JWT.RoleAdmin,
+	"UpdatePerson":    JWT.RoleAdmin,
+	"DeletePerson":    JWT.RoleAdmin,
+	"SetMovieCredits": JWT.RoleAdmin,
+	// переводы названий и описаний
+	"SetMovieTranslation":    JWT.RoleAdmin,
+	"DeleteMovieTranslation": JWT.RoleAdmin,
+	// возрастные рейтинги и описания содержимого
+	"SetMovieCertifications": JWT.RoleAdmin,
+
+	// оценки и комментарии — любой аутентифицированный пользователь;
+	// редактировать комментарий может только автор, удалить чужой — модератор (проверяется в usecase)
+	"CreateRating":  JWT.RoleViewer,
+	"GetMyRating":   JWT.RoleViewer,
+	"DeleteRating":  JWT.RoleViewer,
+	"CreateComment": JWT.RoleViewer,
+	"EditComment":   JWT.RoleViewer,
+	"DeleteComment": JWT.RoleViewer,
+}
+
+// RequiredRole возвращает минимальную роль для операции и признак того, что операция защищена.
+func RequiredRole(operation string) (string, bool) {
+	role, ok := Table[operation]
+	return role, ok
+}
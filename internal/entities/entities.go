@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"slices"
 	"time"
 )
 
@@ -8,19 +9,22 @@ import (
 // Сущность Genre <-> DTO
 // -------------------------
 type Genre struct {
-	ID   int    `json:"id" db:"id"`
-	Name string `json:"name" db:"name"`
+	ID         int    `json:"id" db:"id"`
+	Name       string `json:"name" db:"name"`
+	MovieCount int    `json:"movie_count" db:"movie_count"` // заполняется только при выборке списка жанров
 }
 
 type GenreDTO struct {
-	ID   *int    `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	MovieCount *int    `json:"movie_count,omitempty"`
 }
 
 func (g *Genre) ToDTO() *GenreDTO {
 	return &GenreDTO{
-		ID:   &g.ID,
-		Name: &g.Name,
+		ID:         &g.ID,
+		Name:       &g.Name,
+		MovieCount: &g.MovieCount,
 	}
 }
 
@@ -32,6 +36,9 @@ func (d *GenreDTO) ToEntity() *Genre {
 	if d.Name != nil {
 		g.Name = *d.Name
 	}
+	if d.MovieCount != nil {
+		g.MovieCount = *d.MovieCount
+	}
 	return g
 }
 
@@ -47,7 +54,10 @@ func (d *GenreDTO) ToEntity() *Genre {
 //	release_date DATE         NOT NULL,
 //	duration_min INTEGER      NOT NULL,
 //	created_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
-//	updated_at   TIMESTAMPTZ  NOT NULL DEFAULT now()
+//	updated_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	status       VARCHAR(16)  NOT NULL DEFAULT 'draft',
+//	publish_at   TIMESTAMPTZ,
+//	unpublish_at TIMESTAMPTZ
 //
 // ---------------------------------------
 type Movie struct {
@@ -61,6 +71,56 @@ type Movie struct {
 	Genres      []Genre   // <- поле для жанров
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+
+	// агрегаты оценок из movie_rating_stats
+	RatingStats RatingStats
+
+	// участники фильма из movie_credits, заполняются только при чтении одного фильма
+	Credits []Credit
+
+	// локаль Title и Description; пусто — исходный текст из movies, не локализованный
+	Locale string `json:"locale,omitempty" db:"-"`
+
+	// состояние публикации, одно из MovieStatuses
+	Status      string     `json:"status" db:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty" db:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty" db:"unpublish_at"`
+
+	// возрастные рейтинги по странам и описания содержимого, заполняются только при чтении одного фильма
+	Certifications     []Certification `json:"certifications,omitempty"`
+	ContentDescriptors []string        `json:"content_descriptors,omitempty"`
+}
+
+// Состояния публикации фильма; совпадают с CHECK на movies.status.
+const (
+	MovieStatusDraft     = "draft"
+	MovieStatusScheduled = "scheduled"
+	MovieStatusPublished = "published"
+	MovieStatusArchived  = "archived"
+)
+
+// MovieStatuses — допустимые значения Movie.Status.
+var MovieStatuses = []string{
+	MovieStatusDraft,
+	MovieStatusScheduled,
+	MovieStatusPublished,
+	MovieStatusArchived,
+}
+
+// IsPublic сообщает, виден ли фильм всем пользователям в момент now: он опубликован
+// (или наступило время запланированной публикации) и ещё не снят с публикации.
+// Условие совпадает с publishedMovieSQL в репозитории.
+func (m *Movie) IsPublic(now time.Time) bool {
+	switch m.Status {
+	case MovieStatusPublished:
+	case MovieStatusScheduled:
+		if m.PublishAt == nil || m.PublishAt.After(now) {
+			return false
+		}
+	default:
+		return false
+	}
+	return m.UnpublishAt == nil || m.UnpublishAt.After(now)
 }
 
 type MovieDTO struct {
@@ -75,6 +135,14 @@ type MovieDTO struct {
 	GenreNames  []string   // <- массив имён
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	// агрегаты оценок, заполняются только при чтении
+	AverageRating *float64 `json:"average_rating,omitempty"`
+	Votes         *int     `json:"votes,omitempty"`
+	Histogram     []int    // <- число оценок по score 1..10
+	// состояние публикации
+	Status      *string    `json:"status,omitempty"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
 }
 
 func (m *Movie) ToDTO(genreIDs []int, genreNames []string) *MovieDTO {
@@ -91,6 +159,9 @@ func (m *Movie) ToDTO(genreIDs []int, genreNames []string) *MovieDTO {
 		UpdatedAt:   &m.UpdatedAt,
 		GenreIDs:    genreIDs,
 		GenreNames:  genreNames,
+		Status:      &m.Status,
+		PublishAt:   m.PublishAt,
+		UnpublishAt: m.UnpublishAt,
 	}
 }
 
@@ -138,6 +209,18 @@ func (d *MovieDTO) ToEntity() *Movie {
 	if d.UpdatedAt != nil {
 		m.UpdatedAt = *d.UpdatedAt
 	}
+	if d.AverageRating != nil {
+		m.RatingStats.Average = *d.AverageRating
+	}
+	if d.Votes != nil {
+		m.RatingStats.Votes = *d.Votes
+	}
+	m.RatingStats.Histogram = d.Histogram
+	if d.Status != nil {
+		m.Status = *d.Status
+	}
+	m.PublishAt = d.PublishAt
+	m.UnpublishAt = d.UnpublishAt
 	// GenreIDs не "кладём" внутрь Movie, с ними работает слой репозитория (INSERT в movie_genres).
 	return m
 }
@@ -174,30 +257,171 @@ func (d *MovieGenreDTO) ToEntity() *MovieGenre {
 	return mg
 }
 
+// MovieTranslation ----------------------------------------------------
+// Перевод названия и описания фильма (таблица movie_translations):
+//
+//	movie_id    INTEGER      NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+//	locale      VARCHAR(35)  NOT NULL,
+//	title       VARCHAR(255) NOT NULL,
+//	description TEXT         NOT NULL DEFAULT '',
+//	created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	PRIMARY KEY (movie_id, locale)
+//
+// ----------------------------------------------------------
+type MovieTranslation struct {
+	MovieID     int       `json:"movie_id" db:"movie_id"`
+	Locale      string    `json:"locale" db:"locale"`
+	Title       string    `json:"title" db:"title"`
+	Description string    `json:"description" db:"description"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Certification -------------------------------------------------------
+// Возрастной рейтинг фильма в стране (таблица movie_certifications):
+//
+//	movie_id      INTEGER     NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+//	country       CHAR(2)     NOT NULL,
+//	certification VARCHAR(16) NOT NULL,
+//	min_age       SMALLINT    NOT NULL CHECK (min_age BETWEEN 0 AND 21),
+//	PRIMARY KEY (movie_id, country)
+//
+// ----------------------------------------------------------
+type Certification struct {
+	Country       string `json:"country" db:"country"`             // ISO 3166-1 alpha-2 в верхнем регистре
+	Certification string `json:"certification" db:"certification"` // PG-13, 16+
+	MinAge        int    `json:"min_age" db:"min_age"`
+}
+
+// MaxCertificationAge — верхняя граница min_age; совпадает с CHECK на movie_certifications.min_age.
+const MaxCertificationAge = 21
+
+// Описания содержимого фильма; совпадают с CHECK на movie_content_descriptors.descriptor.
+const (
+	ContentViolence       = "violence"
+	ContentLanguage       = "language"
+	ContentSex            = "sex"
+	ContentNudity         = "nudity"
+	ContentDrugs          = "drugs"
+	ContentHorror         = "horror"
+	ContentDiscrimination = "discrimination"
+	ContentGambling       = "gambling"
+)
+
+// ContentDescriptors — допустимые значения Movie.ContentDescriptors.
+var ContentDescriptors = []string{
+	ContentViolence,
+	ContentLanguage,
+	ContentSex,
+	ContentNudity,
+	ContentDrugs,
+	ContentHorror,
+	ContentDiscrimination,
+	ContentGambling,
+}
+
+// AgeFilter — ограничение выдачи по возрастному рейтингу страны Country.
+// Фильмы без рейтинга в этой стране под активный фильтр не подходят.
+type AgeFilter struct {
+	Country        string
+	MaxAge         *int     // nil — без ограничения по возрасту
+	Certifications []string // пусто — любой рейтинг
+}
+
+// Active сообщает, ограничивает ли фильтр выдачу.
+func (f AgeFilter) Active() bool {
+	return f.MaxAge != nil || len(f.Certifications) > 0
+}
+
+// Allows сообщает, подходит ли фильм с рейтингами certifications под фильтр.
+// Условие совпадает с ageFilterSQL в репозитории.
+func (f AgeFilter) Allows(certifications []Certification) bool {
+	if !f.Active() {
+		return true
+	}
+	for _, c := range certifications {
+		if c.Country != f.Country {
+			continue
+		}
+		if f.MaxAge != nil && c.MinAge > *f.MaxAge {
+			return false
+		}
+		return len(f.Certifications) == 0 || slices.Contains(f.Certifications, c.Certification)
+	}
+	return false
+}
+
+// RatingStats ---------------------------------------------------------
+// Агрегаты оценок фильма (таблица movie_rating_stats):
+//
+//	movie_id   INTEGER      PRIMARY KEY REFERENCES movies(id) ON DELETE CASCADE,
+//	votes      INTEGER      NOT NULL DEFAULT 0,
+//	average    NUMERIC(4,2) NOT NULL DEFAULT 0,
+//	histogram  INTEGER[]    NOT NULL DEFAULT array_fill(0, ARRAY[10]),
+//	updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+//
+// ----------------------------------------------------------
+type RatingStats struct {
+	Average   float64 `json:"average" db:"average"`
+	Votes     int     `json:"votes" db:"votes"`
+	Histogram []int   `json:"histogram" db:"histogram"` // Histogram[i] — число оценок со score = i+1
+}
+
+// Target ----------------------------------------------------------
+// Объект каталога, к которому относятся оценка или комментарий.
+// Задано ровно одно поле; в таблицах ratings и comments остальные колонки равны NULL.
+// ----------------------------------------------------------
+type Target struct {
+	MovieID   int `json:"movie_id,omitempty"`
+	SeriesID  int `json:"series_id,omitempty"`
+	EpisodeID int `json:"episode_id,omitempty"`
+}
+
+// nullableID переводит 0 в NULL для nullable-колонок с внешним ключом.
+func nullableID(id *int) *int {
+	if *id == 0 {
+		return nil
+	}
+	return id
+}
+
 // Rating ----------------------------------------------------------
 // Сущность Rating <-> DTO
 // Таблица ratings:
 //
 //	id         SERIAL PRIMARY KEY,
-//	movie_id   INTEGER     NOT NULL REFERENCES movies(id),
+//	movie_id   INTEGER     REFERENCES movies(id),
 //	user_id    INTEGER     NOT NULL,
 //	score      SMALLINT    NOT NULL CHECK(score BETWEEN 1 AND 10),
 //	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
-//	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+//	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+//	series_id  INTEGER     REFERENCES series(id) ON DELETE CASCADE,
+//	episode_id INTEGER     REFERENCES episodes(id) ON DELETE CASCADE,
+//	CHECK (num_nonnulls(movie_id, series_id, episode_id) = 1)
 //
 // ----------------------------------------------------------
 type Rating struct {
 	ID        int       `json:"id" db:"id"`
 	MovieID   int       `json:"movie_id" db:"movie_id"`
+	SeriesID  int       `json:"series_id" db:"series_id"`
+	EpisodeID int       `json:"episode_id" db:"episode_id"`
 	UserID    int       `json:"user_id" db:"user_id"`
 	Score     int       `json:"score" db:"score"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// Target возвращает объект, к которому относится оценка.
+func (r *Rating) Target() Target {
+	return Target{MovieID: r.MovieID, SeriesID: r.SeriesID, EpisodeID: r.EpisodeID}
+}
+
 type RatingDTO struct {
 	ID        *int       `json:"id,omitempty"`
 	MovieID   *int       `json:"movie_id,omitempty"`
+	SeriesID  *int       `json:"series_id,omitempty"`
+	EpisodeID *int       `json:"episode_id,omitempty"`
 	UserID    *int       `json:"user_id,omitempty"`
 	Score     *int       `json:"score,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -207,7 +431,9 @@ type RatingDTO struct {
 func (r *Rating) ToDTO() *RatingDTO {
 	return &RatingDTO{
 		ID:        &r.ID,
-		MovieID:   &r.MovieID,
+		MovieID:   nullableID(&r.MovieID),
+		SeriesID:  nullableID(&r.SeriesID),
+		EpisodeID: nullableID(&r.EpisodeID),
 		UserID:    &r.UserID,
 		Score:     &r.Score,
 		CreatedAt: &r.CreatedAt,
@@ -223,6 +449,12 @@ func (d *RatingDTO) ToEntity() *Rating {
 	if d.MovieID != nil {
 		r.MovieID = *d.MovieID
 	}
+	if d.SeriesID != nil {
+		r.SeriesID = *d.SeriesID
+	}
+	if d.EpisodeID != nil {
+		r.EpisodeID = *d.EpisodeID
+	}
 	if d.UserID != nil {
 		r.UserID = *d.UserID
 	}
@@ -243,40 +475,76 @@ func (d *RatingDTO) ToEntity() *Rating {
 // Таблица comments:
 //
 //	id         SERIAL PRIMARY KEY,
-//	movie_id   INTEGER     NOT NULL REFERENCES movies(id),
+//	movie_id   INTEGER     REFERENCES movies(id),
 //	user_id    INTEGER     NOT NULL,
 //	text       TEXT        NOT NULL,
 //	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
-//	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+//	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+//	parent_id  INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+//	depth      SMALLINT    NOT NULL DEFAULT 0,
+//	edited     BOOLEAN     NOT NULL DEFAULT false,
+//	series_id  INTEGER     REFERENCES series(id) ON DELETE CASCADE,
+//	episode_id INTEGER     REFERENCES episodes(id) ON DELETE CASCADE,
+//	CHECK (num_nonnulls(movie_id, series_id, episode_id) = 1)
 //
 // ----------------------------------------------------------
 type Comment struct {
 	ID        int       `json:"id" db:"id"`
 	MovieID   int       `json:"movie_id" db:"movie_id"`
+	SeriesID  int       `json:"series_id" db:"series_id"`
+	EpisodeID int       `json:"episode_id" db:"episode_id"`
+	ParentID  int       `json:"parent_id" db:"parent_id"` // 0 — корневой комментарий
 	UserID    int       `json:"user_id" db:"user_id"`
 	Text      string    `json:"text" db:"text"`
+	Depth     int       `json:"depth" db:"depth"`
+	Edited    bool      `json:"edited" db:"edited"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// число прямых ответов и сами ответы (заполняются только при выборке ветки)
+	ReplyCount int        `json:"reply_count" db:"-"`
+	Replies    []*Comment `json:"replies,omitempty" db:"-"`
+}
+
+// Target возвращает объект, к которому относится комментарий.
+func (c *Comment) Target() Target {
+	return Target{MovieID: c.MovieID, SeriesID: c.SeriesID, EpisodeID: c.EpisodeID}
 }
 
 type CommentDTO struct {
-	ID        *int       `json:"id,omitempty"`
-	MovieID   *int       `json:"movie_id,omitempty"`
-	UserID    *int       `json:"user_id,omitempty"`
-	Text      *string    `json:"text,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ID         *int       `json:"id,omitempty"`
+	MovieID    *int       `json:"movie_id,omitempty"`
+	SeriesID   *int       `json:"series_id,omitempty"`
+	EpisodeID  *int       `json:"episode_id,omitempty"`
+	ParentID   *int       `json:"parent_id,omitempty"`
+	UserID     *int       `json:"user_id,omitempty"`
+	Text       *string    `json:"text,omitempty"`
+	Depth      *int       `json:"depth,omitempty"`
+	Edited     *bool      `json:"edited,omitempty"`
+	ReplyCount *int       `json:"reply_count,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 }
 
 func (c *Comment) ToDTO() *CommentDTO {
-	return &CommentDTO{
-		ID:        &c.ID,
-		MovieID:   &c.MovieID,
-		UserID:    &c.UserID,
-		Text:      &c.Text,
-		CreatedAt: &c.CreatedAt,
-		UpdatedAt: &c.UpdatedAt,
+	d := &CommentDTO{
+		ID:         &c.ID,
+		MovieID:    nullableID(&c.MovieID),
+		SeriesID:   nullableID(&c.SeriesID),
+		EpisodeID:  nullableID(&c.EpisodeID),
+		UserID:     &c.UserID,
+		Text:       &c.Text,
+		Depth:      &c.Depth,
+		Edited:     &c.Edited,
+		ReplyCount: &c.ReplyCount,
+		CreatedAt:  &c.CreatedAt,
+		UpdatedAt:  &c.UpdatedAt,
+	}
+	// parent_id в БД nullable: у корневого комментария NULL, а не 0
+	if c.ParentID != 0 {
+		d.ParentID = &c.ParentID
 	}
+	return d
 }
 
 func (d *CommentDTO) ToEntity() *Comment {
@@ -287,12 +555,30 @@ func (d *CommentDTO) ToEntity() *Comment {
 	if d.MovieID != nil {
 		c.MovieID = *d.MovieID
 	}
+	if d.SeriesID != nil {
+		c.SeriesID = *d.SeriesID
+	}
+	if d.EpisodeID != nil {
+		c.EpisodeID = *d.EpisodeID
+	}
+	if d.ParentID != nil {
+		c.ParentID = *d.ParentID
+	}
 	if d.UserID != nil {
 		c.UserID = *d.UserID
 	}
 	if d.Text != nil {
 		c.Text = *d.Text
 	}
+	if d.Depth != nil {
+		c.Depth = *d.Depth
+	}
+	if d.Edited != nil {
+		c.Edited = *d.Edited
+	}
+	if d.ReplyCount != nil {
+		c.ReplyCount = *d.ReplyCount
+	}
 	if d.CreatedAt != nil {
 		c.CreatedAt = *d.CreatedAt
 	}
@@ -301,3 +587,195 @@ func (d *CommentDTO) ToEntity() *Comment {
 	}
 	return c
 }
+
+// Person ----------------------------------------------------------
+// Сущность Person <-> DTO
+// Таблица people:
+//
+//	id         SERIAL PRIMARY KEY,
+//	name       VARCHAR(255) NOT NULL,
+//	bio        TEXT         NOT NULL DEFAULT '',
+//	birth_date DATE,
+//	photo_url  TEXT         NOT NULL DEFAULT '',
+//	created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+//
+// ----------------------------------------------------------
+type Person struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Bio       string    `json:"bio" db:"bio"`
+	BirthDate time.Time `json:"birth_date" db:"birth_date"` // нулевое значение — дата неизвестна
+	PhotoURL  string    `json:"photo_url" db:"photo_url"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type PersonDTO struct {
+	ID        *int       `json:"id,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	Bio       *string    `json:"bio,omitempty"`
+	BirthDate *time.Time `json:"birth_date,omitempty"`
+	PhotoURL  *string    `json:"photo_url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func (p *Person) ToDTO() *PersonDTO {
+	d := &PersonDTO{
+		ID:        &p.ID,
+		Name:      &p.Name,
+		Bio:       &p.Bio,
+		PhotoURL:  &p.PhotoURL,
+		CreatedAt: &p.CreatedAt,
+		UpdatedAt: &p.UpdatedAt,
+	}
+	// birth_date в БД nullable: неизвестная дата хранится как NULL
+	if !p.BirthDate.IsZero() {
+		d.BirthDate = &p.BirthDate
+	}
+	return d
+}
+
+func (d *PersonDTO) ToEntity() *Person {
+	p := &Person{}
+	if d.ID != nil {
+		p.ID = *d.ID
+	}
+	if d.Name != nil {
+		p.Name = *d.Name
+	}
+	if d.Bio != nil {
+		p.Bio = *d.Bio
+	}
+	if d.BirthDate != nil {
+		p.BirthDate = *d.BirthDate
+	}
+	if d.PhotoURL != nil {
+		p.PhotoURL = *d.PhotoURL
+	}
+	if d.CreatedAt != nil {
+		p.CreatedAt = *d.CreatedAt
+	}
+	if d.UpdatedAt != nil {
+		p.UpdatedAt = *d.UpdatedAt
+	}
+	return p
+}
+
+// Credit ----------------------------------------------------------
+// Участие персоны в фильме (таблица movie_credits):
+//
+//	movie_id       INTEGER      NOT NULL REFERENCES movies(id),
+//	person_id      INTEGER      NOT NULL REFERENCES people(id),
+//	role           VARCHAR(16)  NOT NULL CHECK (role IN ('director','actor','writer')),
+//	character_name VARCHAR(255) NOT NULL DEFAULT '',
+//	billing_order  INTEGER      NOT NULL DEFAULT 0,
+//	PRIMARY KEY (movie_id, person_id, role, character_name)
+//
+// ----------------------------------------------------------
+type Credit struct {
+	MovieID      int    `json:"movie_id" db:"movie_id"`
+	PersonID     int    `json:"person_id" db:"person_id"`
+	PersonName   string `json:"person_name" db:"-"` // заполняется только при чтении
+	Role         string `json:"role" db:"role"`
+	Character    string `json:"character" db:"character_name"`
+	BillingOrder int    `json:"billing_order" db:"billing_order"`
+}
+
+// Роли участников фильма; совпадают с CHECK на movie_credits.role.
+const (
+	CreditRoleDirector = "director"
+	CreditRoleActor    = "actor"
+	CreditRoleWriter   = "writer"
+)
+
+// CreditRoles — допустимые значения Credit.Role.
+var CreditRoles = []string{
+	CreditRoleDirector,
+	CreditRoleActor,
+	CreditRoleWriter,
+}
+
+// FilmographyEntry — участие персоны в фильме вместе с краткими данными фильма.
+type FilmographyEntry struct {
+	Credit
+	Title       string    `json:"title" db:"title"`
+	CoverURL    string    `json:"cover_url" db:"cover_url"`
+	ReleaseDate time.Time `json:"release_date" db:"release_date"`
+}
+
+// Series ----------------------------------------------------------
+// Таблица series:
+//
+//	id          SERIAL PRIMARY KEY,
+//	title       VARCHAR(255) NOT NULL,
+//	cover_url   TEXT         NOT NULL DEFAULT '',
+//	description TEXT         NOT NULL DEFAULT '',
+//	created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+//
+// ----------------------------------------------------------
+type Series struct {
+	ID           int       `json:"id" db:"id"`
+	Title        string    `json:"title" db:"title"`
+	CoverURL     string    `json:"cover_url" db:"cover_url"`
+	Description  string    `json:"description" db:"description"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	SeasonCount  int       `json:"season_count" db:"season_count"`
+	EpisodeCount int       `json:"episode_count" db:"episode_count"`
+	RatingStats  RatingStats
+
+	Seasons []Season `json:"seasons,omitempty" db:"-"` // заполняется только в GetSeries
+}
+
+// Season ----------------------------------------------------------
+// Таблица seasons:
+//
+//	id        SERIAL PRIMARY KEY,
+//	series_id INTEGER      NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+//	number    INTEGER      NOT NULL CHECK (number > 0),
+//	title     VARCHAR(255) NOT NULL DEFAULT '',
+//	UNIQUE (series_id, number)
+//
+// ----------------------------------------------------------
+type Season struct {
+	ID           int    `json:"id" db:"id"`
+	SeriesID     int    `json:"series_id" db:"series_id"`
+	Number       int    `json:"number" db:"number"`
+	Title        string `json:"title" db:"title"`
+	EpisodeCount int    `json:"episode_count" db:"episode_count"`
+}
+
+// Episode ----------------------------------------------------------
+// Таблица episodes:
+//
+//	id           SERIAL PRIMARY KEY,
+//	season_id    INTEGER      NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+//	number       INTEGER      NOT NULL CHECK (number > 0),
+//	title        VARCHAR(255) NOT NULL,
+//	video_url    TEXT         NOT NULL,
+//	description  TEXT         NOT NULL DEFAULT '',
+//	release_date DATE         NOT NULL,
+//	duration_min INTEGER      NOT NULL,
+//	created_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	updated_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+//	UNIQUE (season_id, number)
+//
+// ----------------------------------------------------------
+type Episode struct {
+	ID           int       `json:"id" db:"id"`
+	SeriesID     int       `json:"series_id" db:"series_id"` // из seasons.series_id
+	SeasonID     int       `json:"season_id" db:"season_id"`
+	SeasonNumber int       `json:"season_number" db:"season_number"` // из seasons.number
+	Number       int       `json:"number" db:"number"`
+	Title        string    `json:"title" db:"title"`
+	VideoURL     string    `json:"video_url" db:"video_url"`
+	Description  string    `json:"description" db:"description"`
+	ReleaseDate  time.Time `json:"release_date" db:"release_date"`
+	DurationMin  int       `json:"duration_min" db:"duration_min"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	RatingStats  RatingStats
+}
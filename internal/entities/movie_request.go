@@ -1,37 +1,118 @@
 package entities
 
+// Поля сортировки списка фильмов (whitelist для ListMoviesRequest.SortBy).
+const (
+	MovieSortID          = "id"
+	MovieSortTitle       = "title"
+	MovieSortReleaseDate = "release_date"
+	MovieSortDurationMin = "duration_min"
+	MovieSortCreatedAt   = "created_at"
+	MovieSortRating      = "rating"
+)
+
+// MovieSortFields — допустимые значения sort_by.
+var MovieSortFields = []string{
+	MovieSortID,
+	MovieSortTitle,
+	MovieSortReleaseDate,
+	MovieSortDurationMin,
+	MovieSortCreatedAt,
+	MovieSortRating,
+}
+
 // ListMoviesRequest представляет параметры запроса GET /api/v1/movies
-// Параметры передаются как query params: page, per_page, genre_ids
+// Параметры передаются как query params: page, per_page, genre_ids, sort_by, sort_order, page_token
 type ListMoviesRequest struct {
-	Page     int   `json:"page" form:"page"`
-	PerPage  int   `json:"per_page" form:"per_page"`
-	GenreIDs []int `json:"genre_ids" form:"genre_ids"`
+	Page      int    `json:"page" form:"page"`
+	PerPage   int    `json:"per_page" form:"per_page"`
+	GenreIDs  []int  `json:"genre_ids" form:"genre_ids"`
+	SortBy    string `json:"sort_by" form:"sort_by"` // одно из MovieSortFields; пусто — по id
+	SortDesc  bool   `json:"sort_desc" form:"sort_desc"`
+	PageToken string `json:"page_token" form:"page_token"` // курсор вместо page
+	PersonID  int    `json:"person_id" form:"person_id"`   // 0 — без фильтра по участникам
+	// false — только фильмы, видимые всем (см. Movie.IsPublic)
+	IncludeUnpublished bool   `json:"include_unpublished" form:"-"`
+	Status             string `json:"status" form:"status"` // фильтр по состоянию публикации; пусто — любое
+	// ограничение по возрастному рейтингу, в том числе принудительное для детских профилей
+	Age AgeFilter `json:"age" form:"-"`
 }
 
 type ListMoviesResponse struct {
-	Movies []*Movie `json:"movies"`
-	Total  int      `json:"total"`
+	Movies        []*Movie `json:"movies"`
+	Total         int      `json:"total"`
+	NextPageToken string   `json:"next_page_token"`
+}
+
+// SearchMoviesRequest представляет параметры запроса GET /api/v1/movies/search
+// Параметры передаются как query params: q, page, per_page, genres
+type SearchMoviesRequest struct {
+	Query    string `json:"query" form:"q"`
+	Page     int    `json:"page" form:"page"`
+	PerPage  int    `json:"per_page" form:"per_page"`
+	GenreIDs []int  `json:"genre_ids" form:"genre_ids"`
+	// false — только фильмы, видимые всем (см. Movie.IsPublic)
+	IncludeUnpublished bool `json:"include_unpublished" form:"-"`
+	// ограничение по возрастному рейтингу, как в ListMoviesRequest
+	Age AgeFilter `json:"age" form:"-"`
 }
 
 // ListRatingsRequest представляет параметры запроса GET /api/v1/ratings
 // Параметры передаются как query params: page, per_page, genre_ids
 type ListRatingsRequest struct {
-	MovieID int `json:"movie_id" form:"movie_id"`
-	Page    int `json:"page" form:"page"`
-	PerPage int `json:"per_page" form:"per_page"`
+	Target           // фильм, сериал или эпизод
+	Page      int    `json:"page" form:"page"`
+	PerPage   int    `json:"per_page" form:"per_page"`
+	PageToken string `json:"page_token" form:"page_token"`
 }
 
 type ListRatingsResponse struct {
-	Ratings []*Rating `json:"ratings"`
-	Total   int       `json:"total"`
+	Ratings       []*Rating `json:"ratings"`
+	Total         int       `json:"total"`
+	NextPageToken string    `json:"next_page_token"`
 }
 
 type ListCommentsRequest struct {
-	MovieID int `json:"movie_id" form:"movie_id"`
+	Target           // фильм, сериал или эпизод
+	Page      int    `json:"page" form:"page"`
+	PerPage   int    `json:"per_page" form:"per_page"`
+	PageToken string `json:"page_token" form:"page_token"`
+	// ParentID = 0 — корневые комментарии, иначе — прямые ответы на комментарий ParentID
+	ParentID int `json:"parent_id" form:"parent_id"`
+	// WithReplies — вложить в каждый комментарий страницы всю ветку ответов
+	WithReplies bool `json:"with_replies" form:"with_replies"`
+}
+type ListCommentsResponse struct {
+	Comments      []*Comment `json:"comments"`
+	Total         int        `json:"total"`
+	NextPageToken string     `json:"next_page_token"`
+}
+
+// ListPeopleRequest представляет параметры запроса GET /api/v1/people
+// Параметры передаются как query params: q, page, per_page
+type ListPeopleRequest struct {
+	Query   string `json:"query" form:"q"`
+	Page    int    `json:"page" form:"page"`
+	PerPage int    `json:"per_page" form:"per_page"`
+}
+
+type ListPeopleResponse struct {
+	People []*Person `json:"people"`
+	Total  int       `json:"total"`
+}
+
+// ListSeriesRequest представляет параметры запроса GET /api/v1/series
+// Параметры передаются как query params: page, per_page
+type ListSeriesRequest struct {
 	Page    int `json:"page" form:"page"`
 	PerPage int `json:"per_page" form:"per_page"`
 }
-type ListCommentsResponse struct {
-	Comments []*Comment `json:"comments"`
-	Total    int        `json:"total"`
+
+type ListSeriesResponse struct {
+	Series []*Series `json:"series"`
+	Total  int       `json:"total"`
+}
+
+type ListGenresResponse struct {
+	Genres []*Genre `json:"genres"`
+	Total  int      `json:"total"`
 }
@@ -0,0 +1,148 @@
+// Package errs — доменные ошибки сервиса. Репозиторий и usecase возвращают их вместо
+// сырых ошибок драйвера, а транспорты (HTTP и gRPC) переводят Code в свои коды статуса
+// через единый транслятор internal/delivery/errmap.
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code — вид доменной ошибки.
+type Code string
+
+const (
+	CodeInternal         Code = "INTERNAL"
+	CodeNotFound         Code = "NOT_FOUND"
+	CodeConflict         Code = "CONFLICT"
+	CodeInvalidArgument  Code = "INVALID_ARGUMENT"
+	CodeUnauthenticated  Code = "UNAUTHENTICATED"
+	CodePermissionDenied Code = "PERMISSION_DENIED"
+	CodeUnavailable      Code = "UNAVAILABLE"
+)
+
+// Error — доменная ошибка: код, сообщение для клиента и исходная причина (не отдаётся клиенту).
+type Error struct {
+	Code    Code
+	Message string
+	Err     error
+	// Violations — нарушения по полям для ошибок валидации (CodeInvalidArgument).
+	Violations []Violation
+
+	isKind bool
+}
+
+// Violation — нарушение правила валидации в одном поле запроса.
+type Violation struct {
+	Field   string `json:"field"`   // имя поля, для элементов списка — с индексом: genre_ids[1]
+	Rule    string `json:"rule"`    // нарушенное правило: required, max_length, range, url, exists, unique...
+	Message string `json:"message"` // описание для человека
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is позволяет сравнивать с «видовыми» ошибками: errors.Is(err, errs.ErrNotFound)
+// истинно для любой ошибки с кодом NOT_FOUND.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.isKind {
+		return e.Code == t.Code
+	}
+	return e == t
+}
+
+// Видовые ошибки — только для сравнения через errors.Is.
+var (
+	ErrNotFound         = kindOf(CodeNotFound)
+	ErrConflict         = kindOf(CodeConflict)
+	ErrInvalidArgument  = kindOf(CodeInvalidArgument)
+	ErrUnauthenticated  = kindOf(CodeUnauthenticated)
+	ErrPermissionDenied = kindOf(CodePermissionDenied)
+	ErrUnavailable      = kindOf(CodeUnavailable)
+)
+
+func kindOf(code Code) *Error {
+	return &Error{Code: code, Message: string(code), isKind: true}
+}
+
+// New создаёт доменную ошибку с кодом и сообщением.
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Wrap создаёт доменную ошибку с кодом и сообщением поверх исходной причины.
+func Wrap(code Code, err error, message string) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+// Validation возвращает ошибку INVALID_ARGUMENT со списком нарушений по полям.
+func Validation(violations []Violation) *Error {
+	return &Error{Code: CodeInvalidArgument, Message: "validation failed", Violations: violations}
+}
+
+// ViolationsOf возвращает нарушения по полям из цепочки err, если они есть.
+func ViolationsOf(err error) []Violation {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Violations
+	}
+	return nil
+}
+
+// NotFound возвращает ошибку с кодом NOT_FOUND.
+func NotFound(format string, args ...any) *Error {
+	return New(CodeNotFound, fmt.Sprintf(format, args...))
+}
+
+// Conflict возвращает ошибку с кодом CONFLICT.
+func Conflict(format string, args ...any) *Error {
+	return New(CodeConflict, fmt.Sprintf(format, args...))
+}
+
+// InvalidArgument возвращает ошибку с кодом INVALID_ARGUMENT.
+func InvalidArgument(format string, args ...any) *Error {
+	return New(CodeInvalidArgument, fmt.Sprintf(format, args...))
+}
+
+// Unauthenticated возвращает ошибку с кодом UNAUTHENTICATED.
+func Unauthenticated(format string, args ...any) *Error {
+	return New(CodeUnauthenticated, fmt.Sprintf(format, args...))
+}
+
+// PermissionDenied возвращает ошибку с кодом PERMISSION_DENIED.
+func PermissionDenied(format string, args ...any) *Error {
+	return New(CodePermissionDenied, fmt.Sprintf(format, args...))
+}
+
+// CodeOf возвращает код доменной ошибки из цепочки err; для прочих ошибок — CodeInternal.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
+
+// MessageOf возвращает сообщение для клиента. Подробности внутренних ошибок
+// (текст драйвера БД и т.п.) наружу не отдаются.
+func MessageOf(err error) string {
+	var e *Error
+	if !errors.As(err, &e) {
+		return "internal error"
+	}
+	// обёртка fmt.Errorf("%w: ...") над доменной ошибкой — отдаём полный текст
+	if err != error(e) && e.Err == nil {
+		return err.Error()
+	}
+	return e.Message
+}
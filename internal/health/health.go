@@ -0,0 +1,93 @@
+// Package health — проверки готовности сервиса: опрос зависимостей (БД и т. п.) с таймаутом.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status — состояние сервиса или отдельной зависимости.
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// CheckFunc проверяет зависимость и возвращает сведения о ней для отчёта (например, статистику пула).
+type CheckFunc func(ctx context.Context) (details map[string]any, err error)
+
+// Dependency — именованная проверка одной зависимости.
+type Dependency struct {
+	Name  string
+	Check CheckFunc
+}
+
+// Result — результат проверки одной зависимости.
+type Result struct {
+	Status  Status         `json:"status" example:"up"`
+	Error   string         `json:"error,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Report — итог проверки готовности: сервис готов, только если готовы все зависимости.
+type Report struct {
+	Status Status            `json:"status" example:"up"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+// Up сообщает, готовы ли все зависимости.
+func (r Report) Up() bool {
+	return r.Status == StatusUp
+}
+
+// Checker опрашивает зависимости параллельно, каждую — не дольше timeout.
+type Checker struct {
+	timeout      time.Duration
+	dependencies []Dependency
+}
+
+// NewChecker создаёт Checker; timeout <= 0 означает ограничение только контекстом вызова.
+func NewChecker(timeout time.Duration, dependencies ...Dependency) *Checker {
+	return &Checker{timeout: timeout, dependencies: dependencies}
+}
+
+// Check опрашивает все зависимости и собирает отчёт.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{Status: StatusUp, Checks: make(map[string]Result, len(c.dependencies))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, dep := range c.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := c.run(ctx, dep)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[dep.Name] = result
+			if result.Status != StatusUp {
+				report.Status = StatusDown
+			}
+		}()
+	}
+	wg.Wait()
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, dep Dependency) Result {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	details, err := dep.Check(ctx)
+	if err != nil {
+		return Result{Status: StatusDown, Error: err.Error(), Details: details}
+	}
+	return Result{Status: StatusUp, Details: details}
+}
@@ -0,0 +1,55 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"movieService/internal/health"
+)
+
+func TestCheckerAllUp(t *testing.T) {
+	checker := health.NewChecker(time.Second, health.Dependency{
+		Name: "postgres",
+		Check: func(context.Context) (map[string]any, error) {
+			return map[string]any{"total_conns": 4}, nil
+		},
+	})
+
+	report := checker.Check(context.Background())
+	assert.True(t, report.Up())
+	assert.Equal(t, health.StatusUp, report.Checks["postgres"].Status)
+	assert.Equal(t, 4, report.Checks["postgres"].Details["total_conns"])
+}
+
+func TestCheckerReportsFailedDependency(t *testing.T) {
+	checker := health.NewChecker(10*time.Millisecond,
+		health.Dependency{Name: "postgres", Check: func(ctx context.Context) (map[string]any, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}},
+		health.Dependency{Name: "cache", Check: func(context.Context) (map[string]any, error) {
+			return nil, nil
+		}},
+	)
+
+	report := checker.Check(context.Background())
+	assert.False(t, report.Up())
+	assert.Equal(t, health.StatusDown, report.Checks["postgres"].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["postgres"].Error)
+	assert.Equal(t, health.StatusUp, report.Checks["cache"].Status)
+}
+
+func TestCheckerKeepsDetailsOnError(t *testing.T) {
+	checker := health.NewChecker(0, health.Dependency{Name: "postgres", Check: func(context.Context) (map[string]any, error) {
+		return map[string]any{"acquired_conns": 10}, errors.New("connection refused")
+	}})
+
+	result := checker.Check(context.Background()).Checks["postgres"]
+	assert.Equal(t, health.StatusDown, result.Status)
+	assert.Equal(t, "connection refused", result.Error)
+	assert.Equal(t, 10, result.Details["acquired_conns"])
+}
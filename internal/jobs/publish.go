@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+)
+
+// ScheduleFunc публикует и снимает с публикации фильмы, у которых к моменту now наступили
+// publish_at или unpublish_at, и возвращает число изменённых фильмов.
+type ScheduleFunc func(ctx context.Context, now time.Time) (int, error)
+
+// Publisher периодически переводит запланированные фильмы в published, а фильмы
+// с наступившим unpublish_at — в archived.
+type Publisher struct {
+	log   *zap.Logger
+	cfg   config.PublishingConfig
+	apply ScheduleFunc
+	now   func() time.Time
+
+	loop runner
+}
+
+// NewPublisher создаёт Publisher; при cfg.Interval <= 0 задача не запускается.
+func NewPublisher(log *zap.Logger, cfg config.PublishingConfig, apply ScheduleFunc) *Publisher {
+	return &Publisher{log: log, cfg: cfg, apply: apply, now: time.Now}
+}
+
+// RunOnce применяет расписание на текущий момент и возвращает число изменённых фильмов.
+func (p *Publisher) RunOnce(ctx context.Context) (int, error) {
+	return p.apply(ctx, p.now())
+}
+
+func (p *Publisher) OnStart(_ context.Context) error {
+	if p.cfg.Interval <= 0 {
+		p.log.Debug("publish job disabled")
+		return nil
+	}
+
+	p.loop.runEvery(p.cfg.Interval, func(ctx context.Context) {
+		changed, err := p.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			p.log.Error("apply movie schedule failed", zap.Error(err), zap.Int("changed", changed))
+			return
+		}
+		if changed > 0 {
+			p.log.Info("movie schedule applied", zap.Int("changed", changed))
+		}
+	})
+	p.log.Debug("publish job started", zap.Duration("interval", p.cfg.Interval))
+	return nil
+}
+
+func (p *Publisher) OnStop(ctx context.Context) error {
+	return p.loop.stop(ctx)
+}
@@ -0,0 +1,55 @@
+package jobs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+	"movieService/internal/jobs"
+)
+
+func TestPublisherRunOnceAppliesScheduleNow(t *testing.T) {
+	publisher := jobs.NewPublisher(zap.NewNop(), config.PublishingConfig{Interval: time.Minute},
+		func(_ context.Context, now time.Time) (int, error) {
+			assert.WithinDuration(t, time.Now(), now, time.Minute)
+			return 2, nil
+		})
+
+	changed, err := publisher.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+}
+
+func TestPublisherTicks(t *testing.T) {
+	calls := make(chan time.Time, 1)
+	publisher := jobs.NewPublisher(zap.NewNop(), config.PublishingConfig{Interval: 10 * time.Millisecond},
+		func(_ context.Context, now time.Time) (int, error) {
+			select {
+			case calls <- now:
+			default:
+			}
+			return 0, nil
+		})
+
+	require.NoError(t, publisher.OnStart(context.Background()))
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("schedule was not applied")
+	}
+	require.NoError(t, publisher.OnStop(context.Background()))
+}
+
+func TestPublisherDisabled(t *testing.T) {
+	publisher := jobs.NewPublisher(zap.NewNop(), config.PublishingConfig{}, func(context.Context, time.Time) (int, error) {
+		t.Fatal("schedule must not be applied when Interval is 0")
+		return 0, nil
+	})
+	require.NoError(t, publisher.OnStart(context.Background()))
+	require.NoError(t, publisher.OnStop(context.Background()))
+}
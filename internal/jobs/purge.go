@@ -0,0 +1,75 @@
+// Package jobs — фоновые задачи сервиса, запускаемые в жизненном цикле fx.
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+)
+
+// PurgeFunc окончательно удаляет не более limit фильмов, мягко удалённых раньше deletedBefore.
+type PurgeFunc func(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
+
+// Purger периодически очищает мягко удалённые фильмы старше PurgeAfter.
+type Purger struct {
+	log   *zap.Logger
+	cfg   config.SoftDeleteConfig
+	purge PurgeFunc
+	now   func() time.Time
+
+	loop runner
+}
+
+// NewPurger создаёт Purger; при cfg.PurgeAfter <= 0 задача не запускается.
+func NewPurger(log *zap.Logger, cfg config.SoftDeleteConfig, purge PurgeFunc) *Purger {
+	return &Purger{log: log, cfg: cfg, purge: purge, now: time.Now}
+}
+
+// RunOnce выполняет один проход очистки, пока находятся фильмы, и возвращает их общее число.
+func (p *Purger) RunOnce(ctx context.Context) (int, error) {
+	batch := p.cfg.PurgeBatch
+	if batch <= 0 {
+		batch = 100
+	}
+	deletedBefore := p.now().Add(-p.cfg.PurgeAfter)
+
+	total := 0
+	for {
+		purged, err := p.purge(ctx, deletedBefore, batch)
+		total += purged
+		if err != nil || purged < batch {
+			return total, err
+		}
+	}
+}
+
+func (p *Purger) OnStart(_ context.Context) error {
+	if p.cfg.PurgeAfter <= 0 {
+		p.log.Debug("purge job disabled")
+		return nil
+	}
+	interval := p.cfg.PurgeInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	p.loop.runEvery(interval, func(ctx context.Context) {
+		purged, err := p.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			p.log.Error("purge deleted movies failed", zap.Error(err), zap.Int("purged", purged))
+			return
+		}
+		if purged > 0 {
+			p.log.Info("deleted movies purged", zap.Int("purged", purged))
+		}
+	})
+	p.log.Debug("purge job started", zap.Duration("interval", interval), zap.Duration("purge_after", p.cfg.PurgeAfter))
+	return nil
+}
+
+func (p *Purger) OnStop(ctx context.Context) error {
+	return p.loop.stop(ctx)
+}
@@ -0,0 +1,54 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+	"movieService/internal/jobs"
+)
+
+func TestPurgerRunOnceDrainsBatches(t *testing.T) {
+	remaining := 250
+	var calls []int
+	purger := jobs.NewPurger(zap.NewNop(), config.SoftDeleteConfig{PurgeAfter: time.Hour, PurgeBatch: 100},
+		func(_ context.Context, deletedBefore time.Time, limit int) (int, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), deletedBefore, time.Minute)
+			n := min(limit, remaining)
+			remaining -= n
+			calls = append(calls, n)
+			return n, nil
+		})
+
+	purged, err := purger.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 250, purged)
+	assert.Equal(t, []int{100, 100, 50}, calls)
+}
+
+func TestPurgerRunOnceStopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	purger := jobs.NewPurger(zap.NewNop(), config.SoftDeleteConfig{PurgeAfter: time.Hour, PurgeBatch: 10},
+		func(context.Context, time.Time, int) (int, error) {
+			return 3, boom
+		})
+
+	purged, err := purger.RunOnce(context.Background())
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 3, purged)
+}
+
+func TestPurgerDisabled(t *testing.T) {
+	purger := jobs.NewPurger(zap.NewNop(), config.SoftDeleteConfig{}, func(context.Context, time.Time, int) (int, error) {
+		t.Fatal("purge must not run when PurgeAfter is 0")
+		return 0, nil
+	})
+	require.NoError(t, purger.OnStart(context.Background()))
+	require.NoError(t, purger.OnStop(context.Background()))
+}
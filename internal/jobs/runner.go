@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// runner вызывает функцию задачи по таймеру в отдельной горутине от runEvery до stop.
+type runner struct {
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+// runEvery запускает fn каждые interval; первый запуск — через interval после старта.
+// Контекст fn живёт до stop, а не до конца OnStart, из которого вызывается runEvery.
+func (r *runner) runEvery(interval time.Duration, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	}()
+}
+
+// stop отменяет контекст задачи и ждёт завершения текущего запуска не дольше ctx.
+// Для незапущенной задачи ничего не делает.
+func (r *runner) stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	stopped := make(chan struct{})
+	go func() {
+		r.done.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunnerStopCancelsRun(t *testing.T) {
+	var r runner
+	started := make(chan struct{})
+	r.runEvery(time.Millisecond, func(ctx context.Context) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-ctx.Done()
+	})
+
+	<-started
+	require.NoError(t, r.stop(context.Background()))
+}
+
+func TestRunnerStopTimesOut(t *testing.T) {
+	var r runner
+	started := make(chan struct{})
+	release := make(chan struct{})
+	r.runEvery(time.Millisecond, func(context.Context) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+	})
+
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, r.stop(ctx), context.DeadlineExceeded)
+	close(release)
+}
+
+func TestRunnerStopWithoutStart(t *testing.T) {
+	var r runner
+	assert.NoError(t, r.stop(context.Background()))
+}
@@ -0,0 +1,84 @@
+// Package locale выбирает язык ответа по заголовку Accept-Language (RFC 9110, RFC 4647).
+package locale
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// tagPattern — упрощённый языковой тег BCP 47: язык и необязательные подтеги («en», «pt-br», «zh-hant-tw»).
+var tagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Normalize приводит языковой тег к нижнему регистру с дефисами («en_US» → «en-us»)
+// и сообщает, допустим ли он.
+func Normalize(tag string) (string, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	return tag, tagPattern.MatchString(tag)
+}
+
+// Negotiate разбирает значение Accept-Language и возвращает локали, в которых стоит искать
+// перевод, в порядке предпочтения. Каждый тег дополняется более общими («en-us», затем «en»).
+// Список обрывается на локали по умолчанию fallback: на ней написан сам фильм, и переводы
+// менее предпочтительных языков уже не нужны. Пустой результат — отдавать исходный текст.
+func Negotiate(header, fallback string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag, ok := Normalize(tag)
+		if !ok {
+			// «*» и мусор пропускаются: для них подходит локаль по умолчанию
+			continue
+		}
+		q := 1.0
+		if name, value, found := strings.Cut(strings.TrimSpace(params), "="); found && strings.TrimSpace(name) == "q" {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		ranges = append(ranges, weighted{tag: tag, q: q})
+	}
+	// при равном q сохраняется порядок из заголовка
+	slices.SortStableFunc(ranges, func(a, b weighted) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		}
+		return 0
+	})
+
+	fallback, _ = Normalize(fallback)
+	var locales []string
+	for _, r := range ranges {
+		for tag := r.tag; tag != ""; tag = parent(tag) {
+			if tag == fallback {
+				return locales
+			}
+			if !slices.Contains(locales, tag) {
+				locales = append(locales, tag)
+			}
+		}
+	}
+	return locales
+}
+
+// parent отбрасывает последний подтег: «zh-hant-tw» → «zh-hant» → «zh» → «».
+func parent(tag string) string {
+	i := strings.LastIndexByte(tag, '-')
+	if i < 0 {
+		return ""
+	}
+	return tag[:i]
+}
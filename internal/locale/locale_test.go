@@ -0,0 +1,42 @@
+package locale_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"movieService/internal/locale"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := []struct {
+		header string
+		want   []string
+	}{
+		{"", nil},
+		{"en", []string{"en"}},
+		{"en-US,en;q=0.9", []string{"en-us", "en"}},
+		{"de;q=0.5, fr", []string{"fr", "de"}},
+		{"fr, de", []string{"fr", "de"}},
+		// после локали по умолчанию переводы не ищутся
+		{"de, ru, en", []string{"de"}},
+		{"ru-RU, en", []string{"ru-ru"}},
+		{"en;q=0, de, *", []string{"de"}},
+		{"en;q=abc, de", []string{"de"}},
+		{"not a tag, pt_BR", []string{"pt-br", "pt"}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, locale.Negotiate(c.header, "ru"), c.header)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tag, ok := locale.Normalize(" en_US ")
+	assert.True(t, ok)
+	assert.Equal(t, "en-us", tag)
+
+	_, ok = locale.Normalize("english!")
+	assert.False(t, ok)
+	_, ok = locale.Normalize("")
+	assert.False(t, ok)
+}
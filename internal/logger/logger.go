@@ -0,0 +1,71 @@
+// Package logger собирает zap-логгер из конфига и хранит поля запроса (request_id) в контексте,
+// чтобы usecase и репозиторий писали логи, связанные с конкретным запросом.
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"movieService/internal/config"
+)
+
+// Форматы вывода, допустимые в Logging.format.
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+// New создаёт логгер по секции Logging: уровень, формат и сэмплирование одинаковых сообщений.
+func New(cfg *config.Config) (*zap.Logger, error) {
+	lc := cfg.Logging
+
+	level, err := zapcore.ParseLevel(lc.Level)
+	if err != nil {
+		return nil, fmt.Errorf("logging level: %w", err)
+	}
+
+	var zc zap.Config
+	switch strings.ToLower(lc.Format) {
+	case FormatJSON:
+		zc = zap.NewProductionConfig()
+	case FormatConsole, "":
+		zc = zap.NewDevelopmentConfig()
+	default:
+		return nil, fmt.Errorf("unknown logging format %q", lc.Format)
+	}
+	zc.Level = zap.NewAtomicLevelAt(level)
+	zc.Sampling = nil
+	if lc.SamplingInitial > 0 {
+		zc.Sampling = &zap.SamplingConfig{Initial: lc.SamplingInitial, Thereafter: lc.SamplingThereafter}
+	}
+	return zc.Build()
+}
+
+type fieldsKey struct{}
+
+// WithFields добавляет в контекст поля, которые FromContext допишет к каждой записи.
+func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	existing, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	merged := make([]zap.Field, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// FromContext возвращает base с полями запроса из контекста и trace_id текущего спана.
+// Имя и поля base сохраняются, поэтому каждый компонент передаёт свой логгер.
+func FromContext(ctx context.Context, base *zap.Logger) *zap.Logger {
+	fields, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields = append(fields[:len(fields):len(fields)], zap.String("trace_id", sc.TraceID().String()))
+	}
+	if len(fields) == 0 {
+		return base
+	}
+	return base.With(fields...)
+}
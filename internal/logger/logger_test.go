@@ -0,0 +1,56 @@
+package logger_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"movieService/internal/logger"
+)
+
+func TestRedactDSN(t *testing.T) {
+	cases := map[string]string{
+		"host=localhost port=5432 user=postgres password=s3cret dbname=movies sslmode=allow": "host=localhost port=5432 user=postgres password=*** dbname=movies sslmode=allow",
+		"host=db password='with space' dbname=movies":                                        "host=db password=*** dbname=movies",
+		"postgres://postgres:s3cret@db:5432/movies?sslmode=disable":                          "postgres://postgres:***@db:5432/movies?sslmode=disable",
+		"host=db dbname=movies": "host=db dbname=movies",
+	}
+	for dsn, want := range cases {
+		got := logger.RedactDSN(dsn)
+		assert.Equal(t, want, got)
+		assert.NotContains(t, got, "s3cret")
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "abc-123", logger.RequestID("abc-123"))
+
+	generated := logger.RequestID("")
+	assert.Len(t, generated, 32)
+	assert.NotEqual(t, generated, logger.RequestID(""))
+
+	assert.NotEqual(t, "bad id\n", logger.RequestID("bad id\n"))
+	assert.Len(t, logger.RequestID(strings.Repeat("a", 200)), 32)
+}
+
+func TestFromContextAddsRequestID(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	base := zap.New(core)
+
+	ctx := logger.WithRequestID(context.Background(), "req-1")
+	logger.FromContext(ctx, base).Info("hello")
+	logger.FromContext(context.Background(), base).Info("no request")
+
+	entries := logs.All()
+	assert.Equal(t, "req-1", entries[0].ContextMap()["request_id"])
+	assert.NotContains(t, entries[1].ContextMap(), "request_id")
+
+	id, ok := logger.RequestIDFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "req-1", id)
+}
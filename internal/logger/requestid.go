@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader — HTTP-заголовок (и ключ gRPC-метаданных в нижнем регистре) с ID запроса.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength ограничивает длину ID, пришедшего от клиента: он попадает во все записи лога.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestID возвращает ID из входящего запроса, если он допустим, иначе генерирует новый.
+func RequestID(incoming string) string {
+	if incoming != "" && len(incoming) <= maxRequestIDLength && printable(incoming) {
+		return incoming
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithRequestID кладёт ID запроса в контекст и добавляет его в поля логгера.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return WithFields(ctx, zap.String("request_id", id))
+}
+
+// RequestIDFromContext достаёт ID запроса из контекста.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+func printable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x21 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
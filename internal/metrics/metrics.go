@@ -0,0 +1,144 @@
+// Package metrics — Prometheus-метрики сервиса: HTTP-запросы, вызовы usecase и пул соединений с БД.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"movieService/internal/errs"
+)
+
+const namespace = "movie_service"
+
+// Metrics хранит собственный реестр и все метрики сервиса.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	usecaseDuration *prometheus.HistogramVec
+	usecaseErrors   *prometheus.CounterVec
+}
+
+// New создаёт реестр с метриками рантайма Go и процесса.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP requests by method, route template and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by method, route template and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		usecaseDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "usecase",
+			Name:      "call_duration_seconds",
+			Help:      "Usecase method call latency.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		usecaseErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "usecase",
+			Name:      "errors_total",
+			Help:      "Usecase method errors by domain error code.",
+		}, []string{"method", "code"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.usecaseDuration,
+		m.usecaseErrors,
+	)
+	return m
+}
+
+// Handler отдаёт метрики в формате Prometheus.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTP учитывает завершённый HTTP-запрос; route — шаблон маршрута Gin, а не фактический путь.
+func (m *Metrics) ObserveHTTP(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.httpRequests.WithLabelValues(method, route, code).Inc()
+	m.httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveUsecase учитывает вызов метода usecase; ошибки считаются по коду errs.
+func (m *Metrics) ObserveUsecase(method string, duration time.Duration, err error) {
+	m.usecaseDuration.WithLabelValues(method).Observe(duration.Seconds())
+	if err != nil {
+		m.usecaseErrors.WithLabelValues(method, string(errs.CodeOf(err))).Inc()
+	}
+}
+
+// RegisterPool добавляет метрики пула pgx. stat вызывается при каждом сборе и может вернуть nil,
+// пока пул не создан.
+func (m *Metrics) RegisterPool(stat func() *pgxpool.Stat) {
+	m.registry.MustRegister(&poolCollector{stat: stat})
+}
+
+// poolCollector снимает pgxpool.Stat в момент сбора метрик.
+type poolCollector struct {
+	stat func() *pgxpool.Stat
+}
+
+var (
+	poolAcquired = prometheus.NewDesc(namespace+"_db_pool_acquired_conns",
+		"Connections currently acquired from the pool.", nil, nil)
+	poolIdle = prometheus.NewDesc(namespace+"_db_pool_idle_conns",
+		"Idle connections in the pool.", nil, nil)
+	poolTotal = prometheus.NewDesc(namespace+"_db_pool_total_conns",
+		"Total connections in the pool.", nil, nil)
+	poolMax = prometheus.NewDesc(namespace+"_db_pool_max_conns",
+		"Maximum size of the pool.", nil, nil)
+	poolAcquires = prometheus.NewDesc(namespace+"_db_pool_acquires_total",
+		"Successful acquires from the pool.", nil, nil)
+	poolEmptyAcquires = prometheus.NewDesc(namespace+"_db_pool_empty_acquires_total",
+		"Acquires that had to wait for a connection because the pool was empty.", nil, nil)
+	poolCanceledAcquires = prometheus.NewDesc(namespace+"_db_pool_canceled_acquires_total",
+		"Acquires canceled by context.", nil, nil)
+	poolAcquireWait = prometheus.NewDesc(namespace+"_db_pool_acquire_wait_seconds_total",
+		"Total time spent waiting for a connection from the pool.", nil, nil)
+)
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		poolAcquired, poolIdle, poolTotal, poolMax,
+		poolAcquires, poolEmptyAcquires, poolCanceledAcquires, poolAcquireWait,
+	} {
+		ch <- d
+	}
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.stat()
+	if stat == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(poolAcquired, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdle, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotal, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMax, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolCanceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireWait, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
@@ -0,0 +1,41 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"movieService/internal/errs"
+	"movieService/internal/metrics"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+func TestObserve(t *testing.T) {
+	m := metrics.New()
+	m.ObserveHTTP(http.MethodGet, "/api/movies/:id", http.StatusNotFound, 5*time.Millisecond)
+	m.ObserveUsecase("GetMovie", time.Millisecond, errs.NotFound("movie %d not found", 1))
+	m.ObserveUsecase("GetMovie", time.Millisecond, nil)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `movie_service_http_requests_total{method="GET",route="/api/movies/:id",status="404"} 1`)
+	assert.Contains(t, body, `movie_service_usecase_call_duration_seconds_count{method="GetMovie"} 2`)
+	assert.Contains(t, body, `movie_service_usecase_errors_total{code="NOT_FOUND",method="GetMovie"} 1`)
+}
+
+func TestPoolSkippedUntilCreated(t *testing.T) {
+	m := metrics.New()
+	m.RegisterPool(func() *pgxpool.Stat { return nil })
+
+	assert.NotContains(t, scrape(t, m), "movie_service_db_pool_")
+}
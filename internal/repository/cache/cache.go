@@ -0,0 +1,19 @@
+// Package cache — read-through кэш фильмов поверх postgres.InterfaceRepository.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache — хранилище ключ-значение с TTL. Реализации: Redis для работы и Memory для тестов.
+type Cache interface {
+	// Get возвращает значение и признак попадания; отсутствие ключа — не ошибка.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set сохраняет значение; ttl <= 0 — без срока жизни.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete удаляет ключи; отсутствующие ключи пропускаются.
+	Delete(ctx context.Context, keys ...string) error
+	// Incr атомарно увеличивает целочисленный счётчик и возвращает новое значение.
+	Incr(ctx context.Context, key string) (int64, error)
+}
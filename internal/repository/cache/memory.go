@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Memory — потокобезопасный кэш в памяти процесса; для тестов и запуска без Redis.
+type Memory struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	now   func() time.Time
+}
+
+type memoryItem struct {
+	value     []byte
+	expiresAt time.Time // нулевое — без срока жизни
+}
+
+var _ Cache = (*Memory)(nil)
+
+func NewMemory() *Memory {
+	return &Memory{items: make(map[string]memoryItem), now: time.Now}
+}
+
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !item.expiresAt.IsZero() && !m.now().Before(item.expiresAt) {
+		delete(m.items, key)
+		return nil, false, nil
+	}
+	return item.value, true, nil
+}
+
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := memoryItem{value: value}
+	if ttl > 0 {
+		item.expiresAt = m.now().Add(ttl)
+	}
+	m.items[key] = item
+	return nil
+}
+
+func (m *Memory) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.items, key)
+	}
+	return nil
+}
+
+func (m *Memory) Incr(_ context.Context, key string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var n int64
+	if item, ok := m.items[key]; ok {
+		parsed, err := strconv.ParseInt(string(item.value), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		n = parsed
+	}
+	n++
+	m.items[key] = memoryItem{value: []byte(strconv.FormatInt(n, 10))}
+	return n, nil
+}
@@ -0,0 +1,330 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"movieService/internal/entities"
+	"movieService/internal/logger"
+	"movieService/internal/repository/postgres"
+)
+
+// Счётчики поколений. Ключи кэша включают текущее поколение, поэтому увеличение счётчика
+// разом «забывает» все записи предыдущего поколения без перебора ключей.
+const (
+	// listGenerationKey меняется при любой записи, влияющей на списки фильмов
+	listGenerationKey = "movies:list:gen"
+	// movieGenerationKey меняется, когда устаревают все карточки (например, переименован жанр)
+	movieGenerationKey = "movies:movie:gen"
+)
+
+// Repository — декоратор postgres.InterfaceRepository: GetMovie и ListMovies читаются
+// через кэш, изменяющие методы сбрасывают затронутые записи. Остальные методы
+// передаются репозиторию без изменений. Ошибки кэша не доходят до клиента:
+// запрос выполняется в Postgres, а ошибка пишется в лог.
+type Repository struct {
+	postgres.InterfaceRepository
+
+	cache    Cache
+	log      *zap.Logger
+	movieTTL time.Duration
+	listTTL  time.Duration
+}
+
+var _ postgres.InterfaceRepository = (*Repository)(nil)
+
+func NewRepository(next postgres.InterfaceRepository, cache Cache, log *zap.Logger, movieTTL, listTTL time.Duration) *Repository {
+	return &Repository{
+		InterfaceRepository: next,
+		cache:               cache,
+		log:                 log,
+		movieTTL:            movieTTL,
+		listTTL:             listTTL,
+	}
+}
+
+// --- чтение ---
+
+func (r *Repository) GetMovie(ctx context.Context, movieID int) (*entities.Movie, error) {
+	key, ok := r.movieKey(ctx, movieID)
+	if ok {
+		var movie entities.Movie
+		if r.load(ctx, key, &movie) {
+			return &movie, nil
+		}
+	}
+
+	movie, err := r.InterfaceRepository.GetMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		r.store(ctx, key, movie, r.movieTTL)
+	}
+	return movie, nil
+}
+
+func (r *Repository) ListMovies(ctx context.Context, request *entities.ListMoviesRequest) (*entities.ListMoviesResponse, error) {
+	key, ok := r.listKey(ctx, request)
+	if ok {
+		var resp entities.ListMoviesResponse
+		if r.load(ctx, key, &resp) {
+			return &resp, nil
+		}
+	}
+
+	resp, err := r.InterfaceRepository.ListMovies(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		r.store(ctx, key, resp, r.listTTL)
+	}
+	return resp, nil
+}
+
+// --- запись: сначала Postgres, затем инвалидация ---
+
+func (r *Repository) CreateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (*entities.Movie, error) {
+	created, err := r.InterfaceRepository.CreateMovie(ctx, movie, genreIDs)
+	if err == nil {
+		r.invalidateLists(ctx)
+	}
+	return created, err
+}
+
+func (r *Repository) UpdateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (*entities.Movie, error) {
+	updated, err := r.InterfaceRepository.UpdateMovie(ctx, movie, genreIDs)
+	if err == nil {
+		r.invalidateMovie(ctx, movie.ID)
+	}
+	return updated, err
+}
+
+func (r *Repository) PatchMovie(ctx context.Context, patch *entities.MovieDTO) (*entities.Movie, error) {
+	updated, err := r.InterfaceRepository.PatchMovie(ctx, patch)
+	if err == nil {
+		r.invalidateMovie(ctx, updated.ID)
+	}
+	return updated, err
+}
+
+func (r *Repository) DeleteMovie(ctx context.Context, movie *entities.Movie) error {
+	err := r.InterfaceRepository.DeleteMovie(ctx, movie)
+	if err == nil {
+		r.invalidateMovie(ctx, movie.ID)
+	}
+	return err
+}
+
+func (r *Repository) RestoreMovie(ctx context.Context, movieID int) (*entities.Movie, error) {
+	restored, err := r.InterfaceRepository.RestoreMovie(ctx, movieID)
+	if err == nil {
+		r.invalidateMovie(ctx, movieID)
+	}
+	return restored, err
+}
+
+// TransitionMovie меняет состояние публикации: фильм появляется в публичных списках или пропадает из них.
+func (r *Repository) TransitionMovie(ctx context.Context, movie *entities.Movie, from []string) (*entities.Movie, error) {
+	updated, err := r.InterfaceRepository.TransitionMovie(ctx, movie, from)
+	if err == nil {
+		r.invalidateMovie(ctx, movie.ID)
+	}
+	return updated, err
+}
+
+// ApplyMovieSchedule не сообщает, какие фильмы сменили состояние, поэтому при изменениях сбрасывается всё.
+func (r *Repository) ApplyMovieSchedule(ctx context.Context, now time.Time) (int, error) {
+	changed, err := r.InterfaceRepository.ApplyMovieSchedule(ctx, now)
+	if changed > 0 {
+		r.invalidateAll(ctx)
+	}
+	return changed, err
+}
+
+// CreateRating и DeleteRating меняют агрегаты оценок, которые входят в карточку и списки
+// (и в сортировку по rating). Оценки сериалов и эпизодов закэшированные фильмы не затрагивают.
+func (r *Repository) CreateRating(ctx context.Context, rating *entities.Rating) (*entities.Rating, bool, error) {
+	saved, created, err := r.InterfaceRepository.CreateRating(ctx, rating)
+	if err == nil && rating.MovieID != 0 {
+		r.invalidateMovie(ctx, rating.MovieID)
+	}
+	return saved, created, err
+}
+
+func (r *Repository) DeleteRating(ctx context.Context, rating *entities.Rating) error {
+	err := r.InterfaceRepository.DeleteRating(ctx, rating)
+	if err == nil && rating.MovieID != 0 {
+		r.invalidateMovie(ctx, rating.MovieID)
+	}
+	return err
+}
+
+// UpdateGenre и DeleteGenre меняют жанры внутри любых фильмов, поэтому сбрасывается всё.
+func (r *Repository) UpdateGenre(ctx context.Context, genre *entities.Genre) (*entities.Genre, error) {
+	updated, err := r.InterfaceRepository.UpdateGenre(ctx, genre)
+	if err == nil {
+		r.invalidateAll(ctx)
+	}
+	return updated, err
+}
+
+func (r *Repository) DeleteGenre(ctx context.Context, genre *entities.Genre, force bool) error {
+	err := r.InterfaceRepository.DeleteGenre(ctx, genre, force)
+	if err == nil {
+		r.invalidateAll(ctx)
+	}
+	return err
+}
+
+// SetMovieCredits меняет титры в карточке и выдачу фильтра по person_id.
+func (r *Repository) SetMovieCredits(ctx context.Context, movieID int, credits []entities.Credit) (*entities.Movie, error) {
+	updated, err := r.InterfaceRepository.SetMovieCredits(ctx, movieID, credits)
+	if err == nil {
+		r.invalidateMovie(ctx, movieID)
+	}
+	return updated, err
+}
+
+// SetMovieCertifications меняет рейтинги в карточке и выдачу возрастных фильтров.
+func (r *Repository) SetMovieCertifications(ctx context.Context, movieID int, certifications []entities.Certification, descriptors []string) (*entities.Movie, error) {
+	updated, err := r.InterfaceRepository.SetMovieCertifications(ctx, movieID, certifications, descriptors)
+	if err == nil {
+		r.invalidateMovie(ctx, movieID)
+	}
+	return updated, err
+}
+
+// UpdatePerson и DeletePerson меняют титры внутри любых фильмов, поэтому сбрасывается всё.
+func (r *Repository) UpdatePerson(ctx context.Context, person *entities.Person) (*entities.Person, error) {
+	updated, err := r.InterfaceRepository.UpdatePerson(ctx, person)
+	if err == nil {
+		r.invalidateAll(ctx)
+	}
+	return updated, err
+}
+
+func (r *Repository) DeletePerson(ctx context.Context, person *entities.Person, force bool) error {
+	err := r.InterfaceRepository.DeletePerson(ctx, person, force)
+	if err == nil {
+		r.invalidateAll(ctx)
+	}
+	return err
+}
+
+// --- ключи ---
+
+// generation читает счётчик поколения; отсутствующий счётчик — нулевое поколение.
+func (r *Repository) generation(ctx context.Context, key string) (int64, error) {
+	raw, found, err := r.cache.Get(ctx, key)
+	if err != nil || !found {
+		return 0, err
+	}
+	return strconv.ParseInt(string(raw), 10, 64)
+}
+
+func (r *Repository) movieKey(ctx context.Context, movieID int) (string, bool) {
+	gen, err := r.generation(ctx, movieGenerationKey)
+	if err != nil {
+		r.warn(ctx, "cache generation read failed", err)
+		return "", false
+	}
+	return fmt.Sprintf("movies:movie:g%d:%d", gen, movieID), true
+}
+
+func (r *Repository) listKey(ctx context.Context, request *entities.ListMoviesRequest) (string, bool) {
+	gen, err := r.generation(ctx, listGenerationKey)
+	if err != nil {
+		r.warn(ctx, "cache generation read failed", err)
+		return "", false
+	}
+	// порядок жанров в фильтре не влияет на результат
+	genres := slices.Clone(request.GenreIDs)
+	slices.Sort(genres)
+	genreParts := make([]string, len(genres))
+	for i, id := range genres {
+		genreParts[i] = strconv.Itoa(id)
+	}
+	// возрастной фильтр входит в ключ, только если он активен
+	age := "-"
+	if request.Age.Active() {
+		maxAge := "-"
+		if request.Age.MaxAge != nil {
+			maxAge = strconv.Itoa(*request.Age.MaxAge)
+		}
+		certifications := slices.Clone(request.Age.Certifications)
+		slices.Sort(certifications)
+		age = request.Age.Country + "/" + maxAge + "/" + strings.Join(certifications, ",")
+	}
+	return fmt.Sprintf("movies:list:g%d:page=%d:per=%d:genres=%s:person=%d:sort=%s:desc=%t:token=%s:all=%t:status=%s:age=%s",
+		gen, request.Page, request.PerPage, strings.Join(genreParts, ","), request.PersonID,
+		request.SortBy, request.SortDesc, request.PageToken, request.IncludeUnpublished, request.Status, age,
+	), true
+}
+
+// --- инвалидация ---
+
+// invalidateMovie удаляет карточку фильма и сбрасывает списки.
+func (r *Repository) invalidateMovie(ctx context.Context, movieID int) {
+	if key, ok := r.movieKey(ctx, movieID); ok {
+		if err := r.cache.Delete(ctx, key); err != nil {
+			r.warn(ctx, "cache delete failed", err)
+		}
+	}
+	r.invalidateLists(ctx)
+}
+
+func (r *Repository) invalidateLists(ctx context.Context) {
+	if _, err := r.cache.Incr(ctx, listGenerationKey); err != nil {
+		r.warn(ctx, "cache invalidation failed", err)
+	}
+}
+
+func (r *Repository) invalidateAll(ctx context.Context) {
+	if _, err := r.cache.Incr(ctx, movieGenerationKey); err != nil {
+		r.warn(ctx, "cache invalidation failed", err)
+	}
+	r.invalidateLists(ctx)
+}
+
+// --- сериализация ---
+
+// load читает и декодирует значение; false — промах или ошибка кэша.
+func (r *Repository) load(ctx context.Context, key string, dst any) bool {
+	raw, found, err := r.cache.Get(ctx, key)
+	if err != nil {
+		r.warn(ctx, "cache read failed", err)
+		return false
+	}
+	if !found {
+		return false
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		r.warn(ctx, "cache entry is corrupted", err)
+		return false
+	}
+	return true
+}
+
+func (r *Repository) store(ctx context.Context, key string, value any, ttl time.Duration) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		r.warn(ctx, "cache encode failed", err)
+		return
+	}
+	if err := r.cache.Set(ctx, key, raw, ttl); err != nil {
+		r.warn(ctx, "cache write failed", err)
+	}
+}
+
+func (r *Repository) warn(ctx context.Context, msg string, err error) {
+	logger.FromContext(ctx, r.log).Warn(msg, zap.Error(err))
+}
@@ -0,0 +1,225 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"movieService/internal/entities"
+	"movieService/internal/repository/cache"
+	"movieService/internal/repository/postgres"
+)
+
+// fakeRepository считает обращения к «Postgres»; неиспользуемые методы остаются nil-интерфейсом.
+type fakeRepository struct {
+	postgres.InterfaceRepository
+
+	getCalls  int
+	listCalls int
+	title     string
+	scheduled int
+}
+
+func (f *fakeRepository) GetMovie(_ context.Context, movieID int) (*entities.Movie, error) {
+	f.getCalls++
+	return &entities.Movie{ID: movieID, Title: f.title, Genres: []entities.Genre{{ID: 1, Name: "drama"}}}, nil
+}
+
+func (f *fakeRepository) ListMovies(_ context.Context, _ *entities.ListMoviesRequest) (*entities.ListMoviesResponse, error) {
+	f.listCalls++
+	return &entities.ListMoviesResponse{Movies: []*entities.Movie{{ID: 1, Title: f.title}}, Total: 1}, nil
+}
+
+func (f *fakeRepository) UpdateMovie(_ context.Context, movie *entities.Movie, _ []int) (*entities.Movie, error) {
+	f.title = movie.Title
+	return movie, nil
+}
+
+func (f *fakeRepository) UpdatePerson(_ context.Context, person *entities.Person) (*entities.Person, error) {
+	return person, nil
+}
+
+func (f *fakeRepository) CreateRating(_ context.Context, rating *entities.Rating) (*entities.Rating, bool, error) {
+	return rating, true, nil
+}
+
+func (f *fakeRepository) ApplyMovieSchedule(_ context.Context, _ time.Time) (int, error) {
+	return f.scheduled, nil
+}
+
+// brokenCache имитирует недоступный Redis.
+type brokenCache struct{}
+
+var errUnavailable = errors.New("redis: connection refused")
+
+func (brokenCache) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errUnavailable
+}
+func (brokenCache) Set(context.Context, string, []byte, time.Duration) error {
+	return errUnavailable
+}
+func (brokenCache) Delete(context.Context, ...string) error     { return errUnavailable }
+func (brokenCache) Incr(context.Context, string) (int64, error) { return 0, errUnavailable }
+
+func newRepository(next postgres.InterfaceRepository, c cache.Cache) *cache.Repository {
+	return cache.NewRepository(next, c, zap.NewNop(), time.Minute, time.Minute)
+}
+
+func TestGetMovieReadThrough(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+
+	first, err := repo.GetMovie(ctx, 7)
+	require.NoError(t, err)
+	second, err := repo.GetMovie(ctx, 7)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, pg.getCalls)
+	assert.Equal(t, first, second)
+}
+
+func TestUpdateMovieInvalidates(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+	req := &entities.ListMoviesRequest{Page: 1, PerPage: 10}
+
+	_, _ = repo.GetMovie(ctx, 7)
+	_, _ = repo.ListMovies(ctx, req)
+	_, err := repo.UpdateMovie(ctx, &entities.Movie{ID: 7, Title: "Aliens"}, nil)
+	require.NoError(t, err)
+
+	movie, _ := repo.GetMovie(ctx, 7)
+	list, _ := repo.ListMovies(ctx, req)
+	assert.Equal(t, "Aliens", movie.Title)
+	assert.Equal(t, "Aliens", list.Movies[0].Title)
+	assert.Equal(t, 2, pg.getCalls)
+	assert.Equal(t, 2, pg.listCalls)
+}
+
+func TestListMoviesKeyedByFilter(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, GenreIDs: []int{2, 1}})
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, GenreIDs: []int{1, 2}})
+	assert.Equal(t, 1, pg.listCalls)
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 2, PerPage: 10, GenreIDs: []int{1, 2}})
+	assert.Equal(t, 2, pg.listCalls)
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 2, PerPage: 10, GenreIDs: []int{1, 2}, PersonID: 5})
+	assert.Equal(t, 3, pg.listCalls)
+}
+
+func TestListMoviesKeyedByAgeFilter(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+	twelve, sixteen := 12, 16
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10})
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "US"}})
+	assert.Equal(t, 1, pg.listCalls, "inactive filter shares the unfiltered page")
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "US", MaxAge: &twelve}})
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "US", MaxAge: &sixteen}})
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "RU", MaxAge: &sixteen}})
+	assert.Equal(t, 4, pg.listCalls)
+
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "US", Certifications: []string{"PG", "G"}}})
+	_, _ = repo.ListMovies(ctx, &entities.ListMoviesRequest{Page: 1, PerPage: 10, Age: entities.AgeFilter{Country: "US", Certifications: []string{"G", "PG"}}})
+	assert.Equal(t, 5, pg.listCalls)
+}
+
+func TestPersonUpdateInvalidatesAllMovies(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+
+	_, _ = repo.GetMovie(ctx, 7)
+	_, _ = repo.GetMovie(ctx, 8)
+	_, err := repo.UpdatePerson(ctx, &entities.Person{ID: 1, Name: "Sigourney Weaver"})
+	require.NoError(t, err)
+	_, _ = repo.GetMovie(ctx, 7)
+	_, _ = repo.GetMovie(ctx, 8)
+
+	assert.Equal(t, 4, pg.getCalls)
+}
+
+func TestRatingChangeInvalidatesMovie(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+
+	_, _ = repo.GetMovie(ctx, 7)
+	_, _, err := repo.CreateRating(ctx, &entities.Rating{MovieID: 7, UserID: 1, Score: 9})
+	require.NoError(t, err)
+	_, _ = repo.GetMovie(ctx, 7)
+
+	assert.Equal(t, 2, pg.getCalls)
+}
+
+func TestSeriesRatingKeepsMovieCache(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+
+	_, _ = repo.GetMovie(ctx, 7)
+	_, _, err := repo.CreateRating(ctx, &entities.Rating{SeriesID: 3, UserID: 1, Score: 9})
+	require.NoError(t, err)
+	_, _ = repo.GetMovie(ctx, 7)
+
+	assert.Equal(t, 1, pg.getCalls)
+}
+
+func TestMovieScheduleInvalidatesOnlyOnChange(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, cache.NewMemory())
+	req := &entities.ListMoviesRequest{Page: 1, PerPage: 10}
+
+	_, _ = repo.ListMovies(ctx, req)
+	_, err := repo.ApplyMovieSchedule(ctx, time.Now())
+	require.NoError(t, err)
+	_, _ = repo.ListMovies(ctx, req)
+	assert.Equal(t, 1, pg.listCalls)
+
+	pg.scheduled = 2
+	_, err = repo.ApplyMovieSchedule(ctx, time.Now())
+	require.NoError(t, err)
+	_, _ = repo.ListMovies(ctx, req)
+	assert.Equal(t, 2, pg.listCalls)
+}
+
+func TestFallbackWhenCacheUnavailable(t *testing.T) {
+	ctx := context.Background()
+	pg := &fakeRepository{title: "Alien"}
+	repo := newRepository(pg, brokenCache{})
+
+	movie, err := repo.GetMovie(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "Alien", movie.Title)
+
+	_, err = repo.UpdateMovie(ctx, &entities.Movie{ID: 7, Title: "Aliens"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pg.getCalls)
+}
+
+func TestMemoryExpires(t *testing.T) {
+	ctx := context.Background()
+	m := cache.NewMemory()
+
+	require.NoError(t, m.Set(ctx, "k", []byte("v"), time.Millisecond))
+	time.Sleep(2 * time.Millisecond)
+	_, found, err := m.Get(ctx, "k")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
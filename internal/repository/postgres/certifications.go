@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"movieService/internal/entities"
+)
+
+const (
+	listMovieCertificationsSQL   = `SELECT country, certification, min_age FROM movie_certifications WHERE movie_id=$1 ORDER BY country`
+	insertMovieCertificationSQL  = `INSERT INTO movie_certifications (movie_id, country, certification, min_age) VALUES ($1,$2,$3,$4)`
+	deleteMovieCertificationsSQL = `DELETE FROM movie_certifications WHERE movie_id=$1`
+
+	listMovieDescriptorsSQL   = `SELECT descriptor FROM movie_content_descriptors WHERE movie_id=$1 ORDER BY descriptor`
+	insertMovieDescriptorsSQL = `INSERT INTO movie_content_descriptors (movie_id, descriptor) SELECT $1, unnest($2::text[])`
+	deleteMovieDescriptorsSQL = `DELETE FROM movie_content_descriptors WHERE movie_id=$1`
+)
+
+// SetMovieCertifications replaces all certifications and content descriptors of the movie
+// and returns the movie with the new ones.
+func (r *Repository) SetMovieCertifications(ctx context.Context, movieID int, certifications []entities.Certification, descriptors []string) (updated *entities.Movie, err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	// та же блокировка, что и у титров: замены содержимого фильма не перемешиваются
+	var id int
+	if err = tx.QueryRow(ctx, lockMovieCreditsSQL, movieID).Scan(&id); err != nil {
+		return nil, dbError(err)
+	}
+	if _, err = tx.Exec(ctx, deleteMovieCertificationsSQL, id); err != nil {
+		return nil, dbError(err)
+	}
+	for _, c := range certifications {
+		if _, err = tx.Exec(ctx, insertMovieCertificationSQL, id, c.Country, c.Certification, c.MinAge); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	if _, err = tx.Exec(ctx, deleteMovieDescriptorsSQL, id); err != nil {
+		return nil, dbError(err)
+	}
+	if len(descriptors) > 0 {
+		if _, err = tx.Exec(ctx, insertMovieDescriptorsSQL, id, descriptors); err != nil {
+			return nil, dbError(err)
+		}
+	}
+
+	return r.getMovieTx(ctx, tx, id)
+}
+
+// movieCertifications reads certifications of the movie ordered by country.
+func movieCertifications(ctx context.Context, q queryer, movieID int) ([]entities.Certification, error) {
+	rows, err := q.Query(ctx, listMovieCertificationsSQL, movieID)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	certifications := make([]entities.Certification, 0)
+	for rows.Next() {
+		var c entities.Certification
+		if err := rows.Scan(&c.Country, &c.Certification, &c.MinAge); err != nil {
+			return nil, dbError(err)
+		}
+		certifications = append(certifications, c)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return certifications, nil
+}
+
+// movieDescriptors reads content descriptors of the movie in alphabetical order.
+func movieDescriptors(ctx context.Context, q queryer, movieID int) ([]string, error) {
+	rows, err := q.Query(ctx, listMovieDescriptorsSQL, movieID)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	descriptors := make([]string, 0)
+	for rows.Next() {
+		var d string
+		if err := rows.Scan(&d); err != nil {
+			return nil, dbError(err)
+		}
+		descriptors = append(descriptors, d)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return descriptors, nil
+}
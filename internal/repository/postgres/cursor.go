@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"movieService/internal/errs"
+)
+
+// ErrInvalidPageToken возвращается для page_token, который не удалось разобрать
+// или который выдан для другой сортировки или другого списка.
+var ErrInvalidPageToken = errs.New(errs.CodeInvalidArgument, "invalid page token")
+
+// Lists of ratings and comments; recorded in the token so it can not be replayed against another list.
+const (
+	cursorListRatings  = "ratings"
+	cursorListComments = "comments"
+)
+
+// pageCursor is the content of the opaque page_token: sort key and id of the last row of the page.
+// Rating and comment tokens also carry the list, its target and the parent comment they were issued for.
+type pageCursor struct {
+	SortBy string `json:"s,omitempty"`
+	Desc   bool   `json:"d,omitempty"`
+	Key    string `json:"k,omitempty"`
+	List   string `json:"l,omitempty"`
+	Target string `json:"t,omitempty"`
+	Parent int    `json:"p,omitempty"`
+	ID     int    `json:"i"`
+}
+
+// sameList reports whether the cursor was issued for the list described by scope.
+func (c *pageCursor) sameList(scope pageCursor) bool {
+	return c.List == scope.List && c.Target == scope.Target && c.Parent == scope.Parent
+}
+
+// encodeCursor packs cursor into URL-safe token.
+func encodeCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor unpacks token produced by encodeCursor.
+func decodeCursor(token string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	c := &pageCursor{}
+	if err := json.Unmarshal(b, c); err != nil || c.ID <= 0 {
+		return nil, ErrInvalidPageToken
+	}
+	return c, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	issued := pageCursor{List: cursorListComments, Target: movieQueries.targetKey(7), Parent: 3, ID: 42}
+
+	decoded, err := decodeCursor(encodeCursor(issued))
+	require.NoError(t, err)
+	assert.Equal(t, issued, *decoded)
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	for _, token := range []string{"not base64!", encodeCursor(pageCursor{}), "bnVsbA"} {
+		_, err := decodeCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidPageToken, token)
+	}
+}
+
+func TestCursorSameList(t *testing.T) {
+	movieRatings := pageCursor{List: cursorListRatings, Target: movieQueries.targetKey(7)}
+	cursor := movieRatings
+	cursor.ID = 10
+
+	cases := []struct {
+		name  string
+		scope pageCursor
+		want  bool
+	}{
+		{"same list", movieRatings, true},
+		{"other movie", pageCursor{List: cursorListRatings, Target: movieQueries.targetKey(8)}, false},
+		{"series with the same id", pageCursor{List: cursorListRatings, Target: seriesQueries.targetKey(7)}, false},
+		{"comments of the movie", pageCursor{List: cursorListComments, Target: movieQueries.targetKey(7)}, false},
+		{"replies of the movie", pageCursor{List: cursorListRatings, Target: movieQueries.targetKey(7), Parent: 5}, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, cursor.sameList(c.scope), c.name)
+	}
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"movieService/internal/errs"
+)
+
+// SQLSTATE codes translated to domain errors.
+const (
+	sqlStateForeignKeyViolation = "23503"
+	sqlStateUniqueViolation     = "23505"
+	sqlStateCheckViolation      = "23514"
+	sqlStateNotNullViolation    = "23502"
+	sqlStateTooManyConnections  = "53300"
+	sqlStateAdminShutdown       = "57P01"
+	sqlStateCannotConnectNow    = "57P03"
+)
+
+// dbError translates pgx and PostgreSQL errors into domain errors from errs.
+// Domain errors are returned as is, so it is safe to apply it several times.
+func dbError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var domainErr *errs.Error
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errs.Wrap(errs.CodeNotFound, err, "not found")
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == sqlStateForeignKeyViolation:
+			return errs.Wrap(errs.CodeInvalidArgument, err, "referenced entity does not exist or is still in use")
+		case pgErr.Code == sqlStateUniqueViolation:
+			return errs.Wrap(errs.CodeConflict, err, "already exists")
+		case pgErr.Code == sqlStateCheckViolation, pgErr.Code == sqlStateNotNullViolation:
+			return errs.Wrap(errs.CodeInvalidArgument, err, "value violates constraint "+pgErr.ConstraintName)
+		case pgErr.Code[:2] == "22": // data exception: неверный формат, выход за диапазон и т.п.
+			return errs.Wrap(errs.CodeInvalidArgument, err, "invalid value")
+		case pgErr.Code[:2] == "08", pgErr.Code == sqlStateTooManyConnections,
+			pgErr.Code == sqlStateAdminShutdown, pgErr.Code == sqlStateCannotConnectNow:
+			return errs.Wrap(errs.CodeUnavailable, err, "database is unavailable")
+		}
+		return errs.Wrap(errs.CodeInternal, err, "database error")
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || pgconn.Timeout(err) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return errs.Wrap(errs.CodeUnavailable, err, "database is unavailable")
+	}
+	return errs.Wrap(errs.CodeInternal, err, "database error")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "google.golang.org/protobuf/types/known/emptypb"
 	"movieService/internal/entities"
+	"time"
 )
 
 type InterfaceRepository interface {
@@ -11,14 +12,49 @@ type InterfaceRepository interface {
 	GetMovie(ctx context.Context, movieID int) (*entities.Movie, error)
 	CreateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (*entities.Movie, error)
 	DeleteMovie(ctx context.Context, movie *entities.Movie) error
+	RestoreMovie(ctx context.Context, movieID int) (*entities.Movie, error)
+	PurgeMovie(ctx context.Context, movieID int) error
+	PurgeDeletedMovies(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
+	UpdateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (*entities.Movie, error)
+	PatchMovie(ctx context.Context, patch *entities.MovieDTO) (*entities.Movie, error)
+	SearchMovies(ctx context.Context, request *entities.SearchMoviesRequest) (*entities.ListMoviesResponse, error)
+	TransitionMovie(ctx context.Context, movie *entities.Movie, from []string) (*entities.Movie, error)
+	ApplyMovieSchedule(ctx context.Context, now time.Time) (int, error)
+
+	ListMovieTranslations(ctx context.Context, movieID int) ([]*entities.MovieTranslation, error)
+	SetMovieTranslation(ctx context.Context, translation *entities.MovieTranslation) (*entities.MovieTranslation, error)
+	DeleteMovieTranslation(ctx context.Context, movieID int, locale string) error
+	TranslateMovies(ctx context.Context, movies []*entities.Movie, locales []string) error
 
 	ListRatings(ctx context.Context, request *entities.ListRatingsRequest) (*entities.ListRatingsResponse, error)
-	GetRating(ctx context.Context, MovieID int, RatingID int) (*entities.Rating, error)
-	CreateRating(ctx context.Context, rating *entities.Rating) (*entities.Rating, error)
+	GetRating(ctx context.Context, target entities.Target, ratingID int) (*entities.Rating, error)
+	GetRatingByUser(ctx context.Context, target entities.Target, userID int) (*entities.Rating, error)
+	CreateRating(ctx context.Context, rating *entities.Rating) (*entities.Rating, bool, error)
 	DeleteRating(ctx context.Context, rating *entities.Rating) error
 
 	ListComments(ctx context.Context, request *entities.ListCommentsRequest) (*entities.ListCommentsResponse, error)
-	GetComment(ctx context.Context, MovieID int, CommentID int) (*entities.Comment, error)
+	GetComment(ctx context.Context, target entities.Target, commentID int) (*entities.Comment, error)
 	CreateComment(ctx context.Context, comment *entities.Comment) (*entities.Comment, error)
+	UpdateComment(ctx context.Context, comment *entities.Comment) (*entities.Comment, error)
 	DeleteComment(ctx context.Context, comment *entities.Comment) error
+
+	ListGenres(ctx context.Context) (*entities.ListGenresResponse, error)
+	CreateGenre(ctx context.Context, genre *entities.Genre) (*entities.Genre, error)
+	UpdateGenre(ctx context.Context, genre *entities.Genre) (*entities.Genre, error)
+	DeleteGenre(ctx context.Context, genre *entities.Genre, force bool) error
+
+	ListPeople(ctx context.Context, request *entities.ListPeopleRequest) (*entities.ListPeopleResponse, error)
+	GetPerson(ctx context.Context, personID int) (*entities.Person, error)
+	CreatePerson(ctx context.Context, person *entities.Person) (*entities.Person, error)
+	UpdatePerson(ctx context.Context, person *entities.Person) (*entities.Person, error)
+	DeletePerson(ctx context.Context, person *entities.Person, force bool) error
+	ListFilmography(ctx context.Context, personID int, age entities.AgeFilter) ([]*entities.FilmographyEntry, error)
+	SetMovieCredits(ctx context.Context, movieID int, credits []entities.Credit) (*entities.Movie, error)
+	SetMovieCertifications(ctx context.Context, movieID int, certifications []entities.Certification, descriptors []string) (*entities.Movie, error)
+
+	ListSeries(ctx context.Context, request *entities.ListSeriesRequest) (*entities.ListSeriesResponse, error)
+	GetSeries(ctx context.Context, seriesID int) (*entities.Series, error)
+	ListEpisodes(ctx context.Context, seriesID int, season int) ([]*entities.Episode, error)
+	GetEpisode(ctx context.Context, episodeID int) (*entities.Episode, error)
+	GetNextEpisode(ctx context.Context, episodeID int) (*entities.Episode, error)
 }
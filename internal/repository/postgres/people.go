@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+)
+
+// ErrPersonHasCredits возвращается при удалении персоны без force, если она указана в титрах фильмов.
+var ErrPersonHasCredits = errs.New(errs.CodeConflict, "person is credited in movies")
+
+const (
+	// порядок колонок совпадает со scanPerson
+	personColumnsSQL = `id, name, bio, birth_date, photo_url, created_at, updated_at`
+	// $1 — подстрока имени без учёта регистра, пустая строка — без фильтра
+	filterPeopleSQL = `($1::text = '' OR strpos(lower(name), lower($1)) > 0)`
+
+	listPeopleSQL   = `SELECT ` + personColumnsSQL + ` FROM people WHERE ` + filterPeopleSQL + ` ORDER BY name, id LIMIT $2 OFFSET $3`
+	countPeopleSQL  = `SELECT COUNT(*) FROM people WHERE ` + filterPeopleSQL
+	getPersonSQL    = `SELECT ` + personColumnsSQL + ` FROM people WHERE id=$1`
+	insertPersonSQL = `INSERT INTO people (name, bio, birth_date, photo_url) VALUES ($1,$2,$3,$4) RETURNING ` + personColumnsSQL
+	updatePersonSQL = `UPDATE people SET name=$2, bio=$3, birth_date=$4, photo_url=$5, updated_at=now() WHERE id=$1 RETURNING ` + personColumnsSQL
+
+	lockPersonSQL          = `SELECT id FROM people WHERE id=$1 FOR UPDATE`
+	countPersonCreditsSQL  = `SELECT COUNT(*) FROM movie_credits WHERE person_id=$1`
+	deletePersonCreditsSQL = `DELETE FROM movie_credits WHERE person_id=$1`
+	deletePersonSQL        = `DELETE FROM people WHERE id=$1`
+
+	listMovieCreditsSQL = `
+SELECT mc.movie_id, mc.person_id, p.name, mc.role, mc.character_name, mc.billing_order
+FROM movie_credits mc
+JOIN people p ON p.id = mc.person_id
+WHERE mc.movie_id = $1
+ORDER BY mc.billing_order, p.name, mc.person_id;
+`
+	// блокировка строки фильма сериализует конкурентную замену титров
+	lockMovieCreditsSQL     = `SELECT id FROM movies WHERE id=$1 AND deleted_at IS NULL FOR NO KEY UPDATE`
+	insertMovieCreditSQL    = `INSERT INTO movie_credits (movie_id, person_id, role, character_name, billing_order) VALUES ($1,$2,$3,$4,$5)`
+	deleteMovieCreditsSQL   = `DELETE FROM movie_credits WHERE movie_id=$1`
+	filterMoviesByPersonSQL = `m.id IN (SELECT movie_id FROM movie_credits WHERE person_id = %s)`
+
+	// мягко удалённые и неопубликованные фильмы в фильмографию не попадают;
+	// %s — ageFilterSQL по $2, $3, $4
+	listFilmographySQL = `
+SELECT mc.movie_id, mc.person_id, p.name, mc.role, mc.character_name, mc.billing_order,
+       m.title, m.cover_url, m.release_date
+FROM movie_credits mc
+JOIN people p ON p.id = mc.person_id
+JOIN movies m ON m.id = mc.movie_id AND m.deleted_at IS NULL AND ` + publishedMovieSQL + `
+WHERE mc.person_id = $1
+  AND %s
+ORDER BY m.release_date DESC, m.id, mc.billing_order;
+`
+)
+
+// queryer is implemented by both the pool and a transaction.
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// ListPeople returns people ordered by name, optionally filtered by a name substring.
+func (r *Repository) ListPeople(ctx context.Context, request *entities.ListPeopleRequest) (*entities.ListPeopleResponse, error) {
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+	if request.PerPage <= 0 {
+		request.PerPage = 10
+	}
+	offset := (request.Page - 1) * request.PerPage
+
+	rows, err := r.DB.Query(ctx, listPeopleSQL, request.Query, request.PerPage, offset)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	people := make([]*entities.Person, 0)
+	for rows.Next() {
+		person, err := scanPerson(rows)
+		if err != nil {
+			return nil, dbError(err)
+		}
+		people = append(people, person)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+
+	resp := &entities.ListPeopleResponse{People: people}
+	if err := r.DB.QueryRow(ctx, countPeopleSQL, request.Query).Scan(&resp.Total); err != nil {
+		return nil, dbError(err)
+	}
+	return resp, nil
+}
+
+// GetPerson returns person by id.
+func (r *Repository) GetPerson(ctx context.Context, personID int) (*entities.Person, error) {
+	person, err := scanPerson(r.DB.QueryRow(ctx, getPersonSQL, personID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return person, nil
+}
+
+// CreatePerson inserts new person.
+func (r *Repository) CreatePerson(ctx context.Context, person *entities.Person) (*entities.Person, error) {
+	personDTO := person.ToDTO()
+	created, err := scanPerson(r.DB.QueryRow(ctx, insertPersonSQL,
+		personDTO.Name,
+		personDTO.Bio,
+		personDTO.BirthDate,
+		personDTO.PhotoURL,
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return created, nil
+}
+
+// UpdatePerson fully replaces person fields.
+func (r *Repository) UpdatePerson(ctx context.Context, person *entities.Person) (*entities.Person, error) {
+	personDTO := person.ToDTO()
+	updated, err := scanPerson(r.DB.QueryRow(ctx, updatePersonSQL,
+		personDTO.ID,
+		personDTO.Name,
+		personDTO.Bio,
+		personDTO.BirthDate,
+		personDTO.PhotoURL,
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return updated, nil
+}
+
+// DeletePerson removes person by id.
+// Without force it refuses with ErrPersonHasCredits when movies still credit the person,
+// with force the movie_credits rows are removed in the same transaction.
+func (r *Repository) DeletePerson(ctx context.Context, person *entities.Person, force bool) (err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var id int
+	if err = tx.QueryRow(ctx, lockPersonSQL, person.ID).Scan(&id); err != nil {
+		return dbError(err)
+	}
+
+	var credits int
+	if err = tx.QueryRow(ctx, countPersonCreditsSQL, id).Scan(&credits); err != nil {
+		return dbError(err)
+	}
+	if credits > 0 {
+		if !force {
+			return ErrPersonHasCredits
+		}
+		if _, err = tx.Exec(ctx, deletePersonCreditsSQL, id); err != nil {
+			return dbError(err)
+		}
+	}
+
+	if _, err = tx.Exec(ctx, deletePersonSQL, id); err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+// ListFilmography returns credits of the person in live movies allowed by the age filter, newest movies first.
+func (r *Repository) ListFilmography(ctx context.Context, personID int, age entities.AgeFilter) ([]*entities.FilmographyEntry, error) {
+	country, maxAge, certifications := ageFilterArgs(age)
+	rows, err := r.DB.Query(ctx, fmt.Sprintf(listFilmographySQL, ageCondition("$2", "$3", "$4")),
+		personID, country, maxAge, certifications)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	entries := make([]*entities.FilmographyEntry, 0)
+	for rows.Next() {
+		entry := &entities.FilmographyEntry{}
+		if err := rows.Scan(
+			&entry.MovieID,
+			&entry.PersonID,
+			&entry.PersonName,
+			&entry.Role,
+			&entry.Character,
+			&entry.BillingOrder,
+			&entry.Title,
+			&entry.CoverURL,
+			&entry.ReleaseDate,
+		); err != nil {
+			return nil, dbError(err)
+		}
+		entries = append(entries, entry)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return entries, nil
+}
+
+// SetMovieCredits replaces all credits of the movie and returns the movie with new credits.
+func (r *Repository) SetMovieCredits(ctx context.Context, movieID int, credits []entities.Credit) (updated *entities.Movie, err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var id int
+	if err = tx.QueryRow(ctx, lockMovieCreditsSQL, movieID).Scan(&id); err != nil {
+		return nil, dbError(err)
+	}
+	if _, err = tx.Exec(ctx, deleteMovieCreditsSQL, id); err != nil {
+		return nil, dbError(err)
+	}
+	for _, c := range credits {
+		if _, err = tx.Exec(ctx, insertMovieCreditSQL, id, c.PersonID, c.Role, c.Character, c.BillingOrder); err != nil {
+			return nil, dbError(err)
+		}
+	}
+
+	return r.getMovieTx(ctx, tx, id)
+}
+
+// movieCredits reads credits of the movie in billing order.
+func movieCredits(ctx context.Context, q queryer, movieID int) ([]entities.Credit, error) {
+	rows, err := q.Query(ctx, listMovieCreditsSQL, movieID)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	credits := make([]entities.Credit, 0)
+	for rows.Next() {
+		var c entities.Credit
+		if err := rows.Scan(&c.MovieID, &c.PersonID, &c.PersonName, &c.Role, &c.Character, &c.BillingOrder); err != nil {
+			return nil, dbError(err)
+		}
+		credits = append(credits, c)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return credits, nil
+}
+
+// scanPerson reads a row selected with personColumnsSQL.
+func scanPerson(row pgx.Row) (*entities.Person, error) {
+	personDTO := &entities.PersonDTO{}
+	if err := row.Scan(
+		&personDTO.ID,
+		&personDTO.Name,
+		&personDTO.Bio,
+		&personDTO.BirthDate,
+		&personDTO.PhotoURL,
+		&personDTO.CreatedAt,
+		&personDTO.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return personDTO.ToEntity(), nil
+}
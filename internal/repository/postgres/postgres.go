@@ -2,8 +2,12 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -11,8 +15,16 @@ import (
 
 	"movieService/internal/config"
 	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/logger"
 )
 
+// ErrGenreInUse возвращается при удалении жанра без force, если к нему привязаны фильмы.
+var ErrGenreInUse = errs.New(errs.CodeConflict, "genre is referenced by movies")
+
+// ErrMovieNotDeleted возвращается при попытке окончательно удалить фильм, который не удалён мягко.
+var ErrMovieNotDeleted = errs.New(errs.CodeConflict, "movie must be deleted before purge")
+
 type Repository struct {
 	ctx context.Context
 	log *zap.Logger
@@ -31,8 +43,14 @@ func NewRepository(log *zap.Logger, cfg *config.Config, ctx context.Context) (*R
 func (r *Repository) OnStart(_ context.Context) error {
 	connectionUrl := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", r.cfg.Postgres.Host, r.cfg.Postgres.Port, r.cfg.Postgres.User, r.cfg.Postgres.Password, r.cfg.Postgres.DBName, r.cfg.Postgres.SSLMode)
 
-	r.log.Info(connectionUrl)
-	pool, err := pgxpool.New(r.ctx, connectionUrl)
+	r.log.Info("connecting to postgres", zap.String("dsn", logger.RedactDSN(connectionUrl)))
+	poolConfig, err := pgxpool.ParseConfig(connectionUrl)
+	if err != nil {
+		return err
+	}
+	poolConfig.ConnConfig.Tracer = newQueryTracer(r.log, r.cfg.Postgres.DBName)
+
+	pool, err := pgxpool.NewWithConfig(r.ctx, poolConfig)
 	if err != nil {
 		return err
 	}
@@ -40,78 +58,295 @@ func (r *Repository) OnStart(_ context.Context) error {
 	return nil
 }
 
-func (r *Repository) OnStop(_ context.Context) error {
-	r.DB.Close()
-	return nil
+// OnStop закрывает пул. Close ждёт возврата всех занятых соединений, поэтому ожидание
+// ограничено ctx: серверы к этому моменту уже дождались своих запросов.
+func (r *Repository) OnStop(ctx context.Context) error {
+	if r.DB == nil {
+		return nil
+	}
+	closed := make(chan struct{})
+	go func() {
+		r.DB.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+		return nil
+	case <-ctx.Done():
+		r.log.Warn("postgres pool close timed out", zap.Error(ctx.Err()))
+		return ctx.Err()
+	}
 }
 
+// Stat returns pool statistics for metrics, or nil before the pool is created.
+func (r *Repository) Stat() *pgxpool.Stat {
+	if r.DB == nil {
+		return nil
+	}
+	return r.DB.Stat()
+}
+
+// HealthCheck pings the database and reports pool statistics; used as a readiness dependency.
+func (r *Repository) HealthCheck(ctx context.Context) (map[string]any, error) {
+	if r.DB == nil {
+		return nil, errors.New("pool is not initialized")
+	}
+	stat := r.DB.Stat()
+	details := map[string]any{
+		"total_conns":            stat.TotalConns(),
+		"idle_conns":             stat.IdleConns(),
+		"acquired_conns":         stat.AcquiredConns(),
+		"constructing_conns":     stat.ConstructingConns(),
+		"max_conns":              stat.MaxConns(),
+		"acquire_count":          stat.AcquireCount(),
+		"empty_acquire_count":    stat.EmptyAcquireCount(),
+		"canceled_acquire_count": stat.CanceledAcquireCount(),
+		"acquire_duration_ms":    stat.AcquireDuration().Milliseconds(),
+	}
+	return details, r.DB.Ping(ctx)
+}
+
+// movieSortColumn describes whitelisted sort field of movies.
+type movieSortColumn struct {
+	expr string                       // SQL expression used in ORDER BY and keyset condition
+	cast string                       // SQL type of the cursor key; empty when sorting by id only
+	key  func(*entities.Movie) string // text form of the sort key of the movie for the cursor
+}
+
+// movieSortColumns — whitelist полей сортировки фильмов; в SQL подставляются только эти выражения.
+var movieSortColumns = map[string]movieSortColumn{
+	entities.MovieSortID: {expr: "m.id"},
+	entities.MovieSortTitle: {expr: "m.title", cast: "text", key: func(m *entities.Movie) string {
+		return m.Title
+	}},
+	entities.MovieSortReleaseDate: {expr: "m.release_date", cast: "date", key: func(m *entities.Movie) string {
+		return m.ReleaseDate.Format(time.DateOnly)
+	}},
+	entities.MovieSortDurationMin: {expr: "m.duration_min", cast: "int", key: func(m *entities.Movie) string {
+		return strconv.Itoa(m.DurationMin)
+	}},
+	entities.MovieSortCreatedAt: {expr: "m.created_at", cast: "timestamptz", key: func(m *entities.Movie) string {
+		return m.CreatedAt.Format(time.RFC3339Nano)
+	}},
+	entities.MovieSortRating: {expr: "COALESCE(s.average, 0)", cast: "numeric", key: func(m *entities.Movie) string {
+		return strconv.FormatFloat(m.RatingStats.Average, 'f', -1, 64)
+	}},
+}
+
+// ErrUnknownSortField возвращается для поля сортировки не из whitelist.
+var ErrUnknownSortField = errs.New(errs.CodeInvalidArgument, "unknown sort field")
+
+// selectMoviesSQL — общая часть выборки фильмов; WHERE, ORDER BY и LIMIT дописывает ListMovies.
 const (
-	listMoviesSQL = `
+	selectMoviesSQL = `
 SELECT
   m.id, m.title, m.video_url, m.cover_url, m.description,
   m.release_date, m.duration_min, m.created_at, m.updated_at,
+  m.status, m.publish_at, m.unpublish_at,
   -- массив ID жанров
   COALESCE(array_agg(mg.genre_id ORDER BY mg.genre_id) FILTER (WHERE mg.genre_id IS NOT NULL), '{}')   AS genre_ids,
   -- массив имён жанров в том же порядке
-  COALESCE(array_agg(g.name    ORDER BY mg.genre_id) FILTER (WHERE g.name    IS NOT NULL), '{}')   AS genre_names
+  COALESCE(array_agg(g.name    ORDER BY mg.genre_id) FILTER (WHERE g.name    IS NOT NULL), '{}')   AS genre_names,
+  -- агрегаты оценок
+  COALESCE(s.average, 0)::float8                  AS average_rating,
+  COALESCE(s.votes, 0)                            AS votes,
+  COALESCE(s.histogram, array_fill(0, ARRAY[10])) AS histogram
 FROM movies m
 LEFT JOIN movie_genres mg ON m.id = mg.movie_id
 LEFT JOIN genres        g  ON mg.genre_id = g.id
-GROUP BY m.id
-ORDER BY m.id
-LIMIT $1 OFFSET $2;
+LEFT JOIN movie_rating_stats s ON s.movie_id = m.id
 `
+	filterMoviesByGenresSQL = `m.id IN (SELECT movie_id FROM movie_genres WHERE genre_id = ANY(%s))`
+	countMoviesSQL          = `SELECT COUNT(*) FROM movies m`
+	// мягко удалённые фильмы исключаются из любой выборки
+	notDeletedMovieSQL = `m.deleted_at IS NULL`
+	// фильм виден всем: опубликован (или наступило время запланированной публикации) и ещё не снят;
+	// условие совпадает с entities.Movie.IsPublic и не зависит от того, успела ли отработать фоновая задача
+	publishedMovieSQL       = `(m.status = 'published' OR (m.status = 'scheduled' AND m.publish_at <= now())) AND (m.unpublish_at IS NULL OR m.unpublish_at > now())`
+	filterMoviesByStatusSQL = `m.status = %s`
+	// возрастной фильтр: %[1]s — страна (NULL выключает фильтр), %[2]s — max_age (NULL — любой возраст),
+	// %[3]s — допустимые рейтинги (пустой массив — любой); фильмы без рейтинга в стране не подходят.
+	// Условие совпадает с entities.AgeFilter.Allows
+	ageFilterSQL = `(%[1]s::text IS NULL OR m.id IN (
+  SELECT movie_id FROM movie_certifications
+  WHERE country = %[1]s
+    AND (%[2]s::int IS NULL OR min_age <= %[2]s)
+    AND (cardinality(%[3]s::text[]) = 0 OR certification = ANY(%[3]s))))`
+	// для оценок и комментариев: $1 — ID фильма, который не удалён
+	liveMovieSQL = `EXISTS (SELECT 1 FROM movies WHERE id = $1 AND deleted_at IS NULL)`
 
-	listMoviesByGenresSQL = `
-SELECT
-  m.id, m.title, m.video_url, m.cover_url, m.description,
-  m.release_date, m.duration_min, m.created_at, m.updated_at,
-  COALESCE(array_agg(mg2.genre_id ORDER BY mg2.genre_id) FILTER (WHERE mg2.genre_id IS NOT NULL), '{}') AS genre_ids,
-  COALESCE(array_agg(g.name        ORDER BY mg2.genre_id) FILTER (WHERE g.name        IS NOT NULL), '{}') AS genre_names
-FROM movies m
-JOIN movie_genres mg ON m.id = mg.movie_id
-LEFT JOIN movie_genres mg2 ON m.id = mg2.movie_id
-LEFT JOIN genres        g   ON mg2.genre_id = g.id
-WHERE m.id IN (SELECT movie_id FROM movie_genres WHERE genre_id = ANY($1))
-GROUP BY m.id
-ORDER BY m.id
-LIMIT $2 OFFSET $3;
-`
-	countMoviesSQL         = `SELECT COUNT(*) FROM movies`
-	countMoviesByGenresSQL = `SELECT COUNT(DISTINCT m.id) FROM movies m JOIN movie_genres mg ON m.id = mg.movie_id WHERE mg.genre_id = ANY($1)`
-	getMovieSQL            = `
+	getMovieSQL = `
 SELECT
   m.id, m.title, m.video_url, m.cover_url, m.description,
   m.release_date, m.duration_min, m.created_at, m.updated_at,
+  m.status, m.publish_at, m.unpublish_at,
   COALESCE(array_agg(mg.genre_id ORDER BY mg.genre_id) FILTER (WHERE mg.genre_id IS NOT NULL), '{}')   AS genre_ids,
-  COALESCE(array_agg(g.name       ORDER BY mg.genre_id) FILTER (WHERE g.name        IS NOT NULL), '{}')   AS genre_names
+  COALESCE(array_agg(g.name       ORDER BY mg.genre_id) FILTER (WHERE g.name        IS NOT NULL), '{}')   AS genre_names,
+  COALESCE(s.average, 0)::float8                  AS average_rating,
+  COALESCE(s.votes, 0)                            AS votes,
+  COALESCE(s.histogram, array_fill(0, ARRAY[10])) AS histogram
 FROM movies m
 LEFT JOIN movie_genres mg ON m.id = mg.movie_id
 LEFT JOIN genres        g  ON mg.genre_id = g.id
-WHERE m.id = $1
-GROUP BY m.id;
+LEFT JOIN movie_rating_stats s ON s.movie_id = m.id
+WHERE m.id = $1 AND m.deleted_at IS NULL
+GROUP BY m.id, s.movie_id;
 `
-	insertMovieSQL         = `INSERT INTO movies (title, video_url, cover_url, description, release_date, duration_min) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, created_at, updated_at`
+	insertMovieSQL         = `INSERT INTO movies (title, video_url, cover_url, description, release_date, duration_min) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, created_at, updated_at, status`
 	insertMovieGenreSQL    = `INSERT INTO movie_genres (movie_id, genre_id) VALUES ($1,$2)`
 	deleteMovieSQL         = `DELETE FROM movies WHERE id=$1`
 	deleteMovieGenresSQL   = `DELETE FROM movie_genres WHERE movie_id=$1`
 	deleteMovieRatingsSQL  = `DELETE FROM ratings WHERE movie_id=$1`
 	deleteMovieCommentsSQL = `DELETE FROM comments WHERE movie_id=$1`
 
-	listRatingsSQL  = `SELECT id, movie_id, user_id, score, created_at, updated_at FROM ratings WHERE movie_id=$1 ORDER BY id LIMIT $2 OFFSET $3`
-	countRatingsSQL = `SELECT COUNT(*) FROM ratings WHERE movie_id=$1`
-	getRatingSQL    = `SELECT id, movie_id, user_id, score, created_at, updated_at FROM ratings WHERE movie_id=$1 AND id=$2`
-	insertRatingSQL = `INSERT INTO ratings (movie_id, user_id, score) VALUES ($1,$2,$3) RETURNING id, created_at, updated_at`
-	deleteRatingSQL = `DELETE FROM ratings WHERE movie_id=$1 AND id=$2`
-
-	listCommentsSQL  = `SELECT id, movie_id, user_id, text, created_at, updated_at FROM comments WHERE movie_id=$1 ORDER BY id LIMIT $2 OFFSET $3`
-	countCommentsSQL = `SELECT COUNT(*) FROM comments WHERE movie_id=$1`
-	getCommentSQL    = `SELECT id, movie_id, user_id, text, created_at, updated_at FROM comments WHERE movie_id=$1 AND id=$2`
-	insertCommentSQL = `INSERT INTO comments (movie_id, user_id, text) VALUES ($1,$2,$3) RETURNING id, created_at, updated_at`
-	deleteCommentSQL = `DELETE FROM comments WHERE movie_id=$1 AND id=$2`
+	softDeleteMovieSQL     = `UPDATE movies SET deleted_at = now() WHERE id=$1 AND deleted_at IS NULL`
+	restoreMovieSQL        = `UPDATE movies SET deleted_at = NULL, updated_at = now() WHERE id=$1 AND deleted_at IS NOT NULL RETURNING id`
+	lockMovieForPurgeSQL   = `SELECT deleted_at IS NOT NULL FROM movies WHERE id=$1 FOR UPDATE`
+	listPurgeableMoviesSQL = `SELECT id FROM movies WHERE deleted_at < $1 ORDER BY deleted_at LIMIT $2`
+
+	updateMovieSQL = `
+UPDATE movies
+SET title = $2, video_url = $3, cover_url = $4, description = $5, release_date = $6, duration_min = $7, updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id;
+`
+	// NULL в параметре означает «поле не меняется»
+	patchMovieSQL = `
+UPDATE movies
+SET title        = COALESCE($2, title),
+    video_url    = COALESCE($3, video_url),
+    cover_url    = COALESCE($4, cover_url),
+    description  = COALESCE($5, description),
+    release_date = COALESCE($6, release_date),
+    duration_min = COALESCE($7, duration_min),
+    updated_at   = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id;
+`
+
+	// Запросы оценок и комментариев — шаблоны для newTargetQueries: %[1]s — колонка объекта
+	// (movie_id, series_id или episode_id), %[2]s — проверка, что объект $1 существует и не удалён.
+
+	// порядок колонок совпадает со scanRating
+	ratingColumnsSQL = `id, movie_id, series_id, episode_id, user_id, score, created_at, updated_at`
+
+	listRatingsSQL  = `SELECT ` + ratingColumnsSQL + ` FROM ratings WHERE %[1]s=$1 AND %[2]s ORDER BY id LIMIT $2 OFFSET $3`
+	countRatingsSQL = `SELECT COUNT(*) FROM ratings WHERE %[1]s=$1 AND %[2]s`
+	getRatingSQL    = `SELECT ` + ratingColumnsSQL + ` FROM ratings WHERE %[1]s=$1 AND %[2]s AND id=$2`
+	deleteRatingSQL = `DELETE FROM ratings WHERE %[1]s=$1 AND id=$2`
+
+	// keyset-вариант: $2 — id последней оценки предыдущей страницы
+	listRatingsAfterSQL = `SELECT ` + ratingColumnsSQL + ` FROM ratings WHERE %[1]s=$1 AND %[2]s AND id > $2 ORDER BY id LIMIT $3`
+
+	getUserRatingSQL = `SELECT ` + ratingColumnsSQL + ` FROM ratings WHERE %[1]s=$1 AND %[2]s AND user_id=$2`
+	// xmax = 0 только у только что вставленной строки — так отличаем вставку от обновления
+	upsertRatingSQL = `
+INSERT INTO ratings (%[1]s, user_id, score) VALUES ($1,$2,$3)
+ON CONFLICT (%[1]s, user_id) DO UPDATE SET score = EXCLUDED.score, updated_at = now()
+RETURNING id, created_at, updated_at, (xmax = 0) AS inserted;
+`
+
+	// $1 — tsquery из prefixTSQuery, $2 — фильтр по жанрам (пустой массив — без фильтра),
+	// $3 — показывать и неопубликованные фильмы, %s — ageFilterSQL по $4, $5, $6
+	searchMoviesSQL = `
+SELECT
+  m.id, m.title, m.video_url, m.cover_url, m.description,
+  m.release_date, m.duration_min, m.created_at, m.updated_at,
+  m.status, m.publish_at, m.unpublish_at,
+  COALESCE(array_agg(mg.genre_id ORDER BY mg.genre_id) FILTER (WHERE mg.genre_id IS NOT NULL), '{}') AS genre_ids,
+  COALESCE(array_agg(g.name      ORDER BY mg.genre_id) FILTER (WHERE g.name      IS NOT NULL), '{}') AS genre_names,
+  COALESCE(s.average, 0)::float8                  AS average_rating,
+  COALESCE(s.votes, 0)                            AS votes,
+  COALESCE(s.histogram, array_fill(0, ARRAY[10])) AS histogram
+FROM movies m
+LEFT JOIN movie_genres mg ON m.id = mg.movie_id
+LEFT JOIN genres        g  ON mg.genre_id = g.id
+LEFT JOIN movie_rating_stats s ON s.movie_id = m.id
+WHERE m.search_vector @@ to_tsquery('simple', $1)
+  AND m.deleted_at IS NULL
+  AND (cardinality($2::int[]) = 0 OR m.id IN (SELECT movie_id FROM movie_genres WHERE genre_id = ANY($2)))
+  AND ($3::bool OR ` + publishedMovieSQL + `)
+  AND %s
+GROUP BY m.id, s.movie_id
+ORDER BY ts_rank(m.search_vector, to_tsquery('simple', $1)) DESC, m.id
+LIMIT $7 OFFSET $8;
+`
+	countSearchMoviesSQL = `
+SELECT COUNT(*)
+FROM movies m
+WHERE m.search_vector @@ to_tsquery('simple', $1)
+  AND m.deleted_at IS NULL
+  AND (cardinality($2::int[]) = 0 OR m.id IN (SELECT movie_id FROM movie_genres WHERE genre_id = ANY($2)))
+  AND ($3::bool OR ` + publishedMovieSQL + `)
+  AND %s;
+`
+
+	// блокировка строки фильма сериализует пересчёт агрегатов между конкурентными оценками
+	lockMovieRatingsSQL   = `SELECT id FROM movies WHERE id=$1 AND deleted_at IS NULL FOR NO KEY UPDATE`
+	refreshRatingStatsSQL = `
+INSERT INTO movie_rating_stats (movie_id, votes, average, histogram, updated_at)
+SELECT $1::int,
+       COUNT(*),
+       COALESCE(AVG(score), 0)::NUMERIC(4,2),
+       ARRAY(SELECT COUNT(r.id)::int
+             FROM generate_series(1, 10) AS sc(score)
+             LEFT JOIN ratings r ON r.movie_id = $1 AND r.score = sc.score
+             GROUP BY sc.score
+             ORDER BY sc.score),
+       now()
+FROM ratings
+WHERE movie_id = $1
+ON CONFLICT (movie_id) DO UPDATE
+SET votes = EXCLUDED.votes, average = EXCLUDED.average, histogram = EXCLUDED.histogram, updated_at = EXCLUDED.updated_at;
+`
+
+	// порядок колонок совпадает со scanComment; reply_count — число прямых ответов
+	commentColumnsSQL = `c.id, c.movie_id, c.series_id, c.episode_id, c.parent_id, c.user_id, c.text, c.depth, c.edited, (SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id), c.created_at, c.updated_at`
+	// один уровень ветки: $2 = 0 — корневые комментарии, иначе ответы на комментарий $2
+	commentLevelSQL = `(($2::int = 0 AND c.parent_id IS NULL) OR c.parent_id = $2)`
+
+	listCommentsSQL  = `SELECT ` + commentColumnsSQL + ` FROM comments c WHERE c.%[1]s=$1 AND %[2]s AND ` + commentLevelSQL + ` ORDER BY c.id LIMIT $3 OFFSET $4`
+	countCommentsSQL = `SELECT COUNT(*) FROM comments c WHERE c.%[1]s=$1 AND %[2]s AND ` + commentLevelSQL
+	getCommentSQL    = `SELECT ` + commentColumnsSQL + ` FROM comments c WHERE c.%[1]s=$1 AND %[2]s AND c.id=$2`
+	insertCommentSQL = `INSERT INTO comments (%[1]s, user_id, text, parent_id, depth) SELECT $1,$2,$3,$4,$5 WHERE %[2]s RETURNING id, created_at, updated_at`
+	updateCommentSQL = `UPDATE comments c SET text=$3, edited=true, updated_at=now() WHERE c.%[1]s=$1 AND %[2]s AND c.id=$2 RETURNING ` + commentColumnsSQL
+	deleteCommentSQL = `DELETE FROM comments WHERE %[1]s=$1 AND id=$2`
+
+	// keyset-вариант: $3 — id последнего комментария предыдущей страницы
+	listCommentsAfterSQL = `SELECT ` + commentColumnsSQL + ` FROM comments c WHERE c.%[1]s=$1 AND %[2]s AND ` + commentLevelSQL + ` AND c.id > $3 ORDER BY c.id LIMIT $4`
+
+	// все ответы любой глубины на комментарии $2; родитель всегда идёт раньше своих ответов
+	listCommentRepliesSQL = `
+WITH RECURSIVE thread AS (
+    SELECT c.id FROM comments c WHERE c.%[1]s = $1 AND c.parent_id = ANY($2)
+    UNION ALL
+    SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+)
+SELECT ` + commentColumnsSQL + `
+FROM comments c
+JOIN thread t ON t.id = c.id
+ORDER BY c.depth, c.id;
+`
+
+	listGenresSQL = `
+SELECT g.id, g.name, COUNT(m.id) AS movie_count
+FROM genres g
+LEFT JOIN movie_genres mg ON mg.genre_id = g.id
+LEFT JOIN movies m ON m.id = mg.movie_id AND m.deleted_at IS NULL
+GROUP BY g.id
+ORDER BY g.name;
+`
+	insertGenreSQL       = `INSERT INTO genres (name) VALUES ($1) RETURNING id`
+	updateGenreSQL       = `UPDATE genres SET name=$2 WHERE id=$1 RETURNING id, name`
+	lockGenreSQL         = `SELECT id FROM genres WHERE id=$1 FOR UPDATE`
+	countGenreMoviesSQL  = `SELECT COUNT(*) FROM movie_genres WHERE genre_id=$1`
+	deleteGenreMoviesSQL = `DELETE FROM movie_genres WHERE genre_id=$1`
+	deleteGenreSQL       = `DELETE FROM genres WHERE id=$1`
 )
 
 // ListMovies returns a list of movies with optional filtering by genres.
+// Pages are addressed either by page number (LIMIT/OFFSET with total count)
+// or by page_token (keyset on the sort key plus id, total is not counted).
 func (r *Repository) ListMovies(ctx context.Context, request *entities.ListMoviesRequest) (*entities.ListMoviesResponse, error) {
 	if request.Page <= 0 {
 		request.Page = 1
@@ -120,21 +355,63 @@ func (r *Repository) ListMovies(ctx context.Context, request *entities.ListMovie
 		request.PerPage = 10
 	}
 	offset := (request.Page - 1) * request.PerPage
-	var rows pgx.Rows
-	var err error
+
+	sortBy := request.SortBy
+	if sortBy == "" {
+		sortBy = entities.MovieSortID
+	}
+	column, ok := movieSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSortField, sortBy)
+	}
+
+	where := []string{notDeletedMovieSQL}
+	if !request.IncludeUnpublished {
+		where = append(where, publishedMovieSQL)
+	}
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if request.Status != "" {
+		where = append(where, fmt.Sprintf(filterMoviesByStatusSQL, arg(request.Status)))
+	}
 	if len(request.GenreIDs) > 0 {
-		rows, err = r.DB.Query(
-			ctx,
-			listMoviesByGenresSQL,
-			request.GenreIDs,
-			request.PerPage,
-			offset,
-		)
-	} else {
-		rows, err = r.DB.Query(ctx, listMoviesSQL, request.PerPage, offset)
+		where = append(where, fmt.Sprintf(filterMoviesByGenresSQL, arg(request.GenreIDs)))
+	}
+	if request.PersonID > 0 {
+		where = append(where, fmt.Sprintf(filterMoviesByPersonSQL, arg(request.PersonID)))
 	}
+	if request.Age.Active() {
+		country, maxAge, certifications := ageFilterArgs(request.Age)
+		where = append(where, ageCondition(arg(country), arg(maxAge), arg(certifications)))
+	}
+	// фильтры без keyset-условия нужны для подсчёта total
+	filters, filterArgs := len(where), len(args)
+
+	var cursor *pageCursor
+	if request.PageToken != "" {
+		var err error
+		if cursor, err = decodeCursor(request.PageToken); err != nil {
+			return nil, dbError(err)
+		}
+		if cursor.SortBy != sortBy || cursor.Desc != request.SortDesc {
+			return nil, ErrInvalidPageToken
+		}
+		where = append(where, keysetCondition(column, request.SortDesc, cursor, arg))
+	}
+
+	query := selectMoviesSQL + whereClause(where) +
+		"GROUP BY m.id, s.movie_id\nORDER BY " + movieOrderBy(column, request.SortDesc) +
+		"\nLIMIT " + arg(request.PerPage+1)
+	if cursor == nil {
+		query += " OFFSET " + arg(offset)
+	}
+
+	rows, err := r.DB.Query(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer rows.Close()
 	movies := make([]*entities.Movie, 0)
@@ -150,29 +427,178 @@ func (r *Repository) ListMovies(ctx context.Context, request *entities.ListMovie
 			&movieDTO.DurationMin,
 			&movieDTO.CreatedAt,
 			&movieDTO.UpdatedAt,
+			&movieDTO.Status,
+			&movieDTO.PublishAt,
+			&movieDTO.UnpublishAt,
 			&movieDTO.GenreIDs,   // сканируем массив ID
 			&movieDTO.GenreNames, // сканируем массив имён
+			&movieDTO.AverageRating,
+			&movieDTO.Votes,
+			&movieDTO.Histogram,
 		); err != nil {
-			return nil, err
+			return nil, dbError(err)
 		}
 		movies = append(movies, movieDTO.ToEntity())
 	}
 	if rows.Err() != nil {
-		return nil, rows.Err()
+		return nil, dbError(rows.Err())
 	}
 
-	var total int
-	if len(request.GenreIDs) > 0 {
-		err = r.DB.QueryRow(ctx, countMoviesByGenresSQL, request.GenreIDs).Scan(&total)
-	} else {
-		err = r.DB.QueryRow(ctx, countMoviesSQL).Scan(&total)
+	// лишняя строка означает, что есть следующая страница
+	var nextPageToken string
+	if len(movies) > request.PerPage {
+		movies = movies[:request.PerPage]
+		last := movies[len(movies)-1]
+		next := pageCursor{SortBy: sortBy, Desc: request.SortDesc, ID: last.ID}
+		if column.key != nil {
+			next.Key = column.key(last)
+		}
+		nextPageToken = encodeCursor(next)
+	}
+
+	resp := &entities.ListMoviesResponse{Movies: movies, NextPageToken: nextPageToken}
+	if cursor == nil {
+		if err := r.DB.QueryRow(ctx, countMoviesSQL+whereClause(where[:filters]), args[:filterArgs]...).Scan(&resp.Total); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	return resp, nil
+}
+
+// SearchMovies finds movies by words of the query in title and description, ranked by relevance.
+// Every word is matched by prefix; results can be narrowed by genres.
+func (r *Repository) SearchMovies(ctx context.Context, request *entities.SearchMoviesRequest) (*entities.ListMoviesResponse, error) {
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+	if request.PerPage <= 0 {
+		request.PerPage = 10
+	}
+	offset := (request.Page - 1) * request.PerPage
+
+	tsQuery := prefixTSQuery(request.Query)
+	if tsQuery == "" {
+		return &entities.ListMoviesResponse{Movies: make([]*entities.Movie, 0)}, nil
 	}
+	genreIDs := request.GenreIDs
+	if genreIDs == nil {
+		genreIDs = make([]int, 0)
+	}
+
+	country, maxAge, certifications := ageFilterArgs(request.Age)
+	age := ageCondition("$4", "$5", "$6")
+
+	rows, err := r.DB.Query(ctx, fmt.Sprintf(searchMoviesSQL, age),
+		tsQuery, genreIDs, request.IncludeUnpublished, country, maxAge, certifications, request.PerPage, offset)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+	movies := make([]*entities.Movie, 0)
+	for rows.Next() {
+		movieDTO := &entities.MovieDTO{}
+		if err := rows.Scan(
+			&movieDTO.ID,
+			&movieDTO.Title,
+			&movieDTO.VideoURL,
+			&movieDTO.CoverURL,
+			&movieDTO.Description,
+			&movieDTO.ReleaseDate,
+			&movieDTO.DurationMin,
+			&movieDTO.CreatedAt,
+			&movieDTO.UpdatedAt,
+			&movieDTO.Status,
+			&movieDTO.PublishAt,
+			&movieDTO.UnpublishAt,
+			&movieDTO.GenreIDs,
+			&movieDTO.GenreNames,
+			&movieDTO.AverageRating,
+			&movieDTO.Votes,
+			&movieDTO.Histogram,
+		); err != nil {
+			return nil, dbError(err)
+		}
+		movies = append(movies, movieDTO.ToEntity())
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+
+	var total int
+	if err := r.DB.QueryRow(ctx, fmt.Sprintf(countSearchMoviesSQL, age),
+		tsQuery, genreIDs, request.IncludeUnpublished, country, maxAge, certifications).Scan(&total); err != nil {
+		return nil, dbError(err)
 	}
 	return &entities.ListMoviesResponse{Movies: movies, Total: total}, nil
 }
 
+// prefixTSQuery turns free text into tsquery "word1:* & word2:*".
+// Only letters and digits are kept, so the user input can not break tsquery syntax.
+func prefixTSQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// movieOrderBy builds ORDER BY clause from the whitelisted sort column.
+// m.id is always added as a tie-breaker so that pages are stable.
+func movieOrderBy(column movieSortColumn, desc bool) string {
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+	if column.cast == "" {
+		return "m.id " + direction
+	}
+	return column.expr + " " + direction + ", m.id " + direction
+}
+
+// keysetCondition builds condition selecting rows after the cursor in the sort order.
+func keysetCondition(column movieSortColumn, desc bool, cursor *pageCursor, arg func(any) string) string {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	if column.cast == "" {
+		return "m.id " + op + " " + arg(cursor.ID)
+	}
+	// ключ передаётся текстом и приводится к типу колонки на стороне БД
+	key := arg(cursor.Key) + "::text"
+	if column.cast != "text" {
+		key += "::" + column.cast
+	}
+	return "(" + column.expr + ", m.id) " + op + " (" + key + ", " + arg(cursor.ID) + ")"
+}
+
+// ageCondition fills ageFilterSQL with placeholders of the country, max age and certifications.
+func ageCondition(country, maxAge, certifications string) string {
+	return fmt.Sprintf(ageFilterSQL, country, maxAge, certifications)
+}
+
+// ageFilterArgs returns query arguments for ageCondition; an inactive filter yields a NULL country.
+func ageFilterArgs(f entities.AgeFilter) (country *string, maxAge *int, certifications []string) {
+	certifications = f.Certifications
+	if certifications == nil {
+		certifications = make([]string, 0)
+	}
+	if f.Active() {
+		country = &f.Country
+	}
+	return country, f.MaxAge, certifications
+}
+
+// whereClause joins conditions with AND; returns empty string when there are none.
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conditions, "\n  AND ") + "\n"
+}
+
 func (r *Repository) GetMovie(ctx context.Context, movieID int) (*entities.Movie, error) {
 	dto := &entities.MovieDTO{}
 	// Сканируем в DTO все поля + два массива (genre_ids и genre_names)
@@ -186,26 +612,37 @@ func (r *Repository) GetMovie(ctx context.Context, movieID int) (*entities.Movie
 		&dto.DurationMin,
 		&dto.CreatedAt,
 		&dto.UpdatedAt,
+		&dto.Status,
+		&dto.PublishAt,
+		&dto.UnpublishAt,
 		&dto.GenreIDs,   // []int
 		&dto.GenreNames, // []string
+		&dto.AverageRating,
+		&dto.Votes,
+		&dto.Histogram,
 	); err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	// Преобразуем DTO → Entity (внутри склеиваются ID+Name в []Genre)
-	return dto.ToEntity(), nil
+	movie := dto.ToEntity()
+
+	if err := movieDetails(ctx, r.DB, movie); err != nil {
+		return nil, err
+	}
+	return movie, nil
 }
 
 // CreateMovie inserts new movie and related genres.
-func (r *Repository) CreateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (*entities.Movie, error) {
+func (r *Repository) CreateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (created *entities.Movie, err error) {
 	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer func() {
 		if err != nil {
 			_ = tx.Rollback(ctx)
 		} else {
-			err = tx.Commit(ctx)
+			err = dbError(tx.Commit(ctx))
 		}
 	}()
 
@@ -217,57 +654,245 @@ func (r *Repository) CreateMovie(ctx context.Context, movie *entities.Movie, gen
 		&movieDTO.Description,
 		&movieDTO.ReleaseDate,
 		&movieDTO.DurationMin,
-	).Scan(movieDTO.ID, movieDTO.CreatedAt, movieDTO.UpdatedAt)
+	).Scan(movieDTO.ID, movieDTO.CreatedAt, movieDTO.UpdatedAt, movieDTO.Status)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	movie = movieDTO.ToEntity()
 
-	// genres are stored separately when provided via DTO
-	if dto, ok := interface{}(movie).(interface{ GetGenreIDs() []int }); ok {
-		for _, gID := range dto.GetGenreIDs() {
-			if _, err = tx.Exec(ctx, insertMovieGenreSQL, movie.ID, gID); err != nil {
-				return nil, err
-			}
+	// genres are stored separately in movie_genres
+	for _, gID := range genreIDs {
+		if _, err = tx.Exec(ctx, insertMovieGenreSQL, movie.ID, gID); err != nil {
+			return nil, dbError(err)
 		}
 	}
 
 	return movie, nil
 }
 
-// DeleteMovie removes movie and related entities.
-func (r *Repository) DeleteMovie(ctx context.Context, movie *entities.Movie) error {
-	movieDTO := movie.ToDTO(make([]int, 0), nil)
+// UpdateMovie fully replaces movie fields and its genres.
+func (r *Repository) UpdateMovie(ctx context.Context, movie *entities.Movie, genreIDs []int) (updated *entities.Movie, err error) {
 	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return err
+		return nil, dbError(err)
 	}
 	defer func() {
 		if err != nil {
 			_ = tx.Rollback(ctx)
 		} else {
-			err = tx.Commit(ctx)
+			err = dbError(tx.Commit(ctx))
 		}
 	}()
 
-	if _, err = tx.Exec(ctx, deleteMovieGenresSQL, movieDTO.ID); err != nil {
-		return err
+	var id int
+	if err = tx.QueryRow(ctx, updateMovieSQL,
+		movie.ID,
+		movie.Title,
+		movie.VideoURL,
+		movie.CoverURL,
+		movie.Description,
+		movie.ReleaseDate,
+		movie.DurationMin,
+	).Scan(&id); err != nil {
+		return nil, dbError(err)
+	}
+
+	if err = r.replaceMovieGenres(ctx, tx, id, genreIDs); err != nil {
+		return nil, dbError(err)
+	}
+
+	return r.getMovieTx(ctx, tx, id)
+}
+
+// PatchMovie updates only non-nil fields of the DTO.
+// Genres are rewritten when patch.GenreIDs is not nil (an empty slice clears them).
+func (r *Repository) PatchMovie(ctx context.Context, patch *entities.MovieDTO) (updated *entities.Movie, err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var id int
+	if err = tx.QueryRow(ctx, patchMovieSQL,
+		patch.ID,
+		patch.Title,
+		patch.VideoURL,
+		patch.CoverURL,
+		patch.Description,
+		patch.ReleaseDate,
+		patch.DurationMin,
+	).Scan(&id); err != nil {
+		return nil, dbError(err)
+	}
+
+	if patch.GenreIDs != nil {
+		if err = r.replaceMovieGenres(ctx, tx, id, patch.GenreIDs); err != nil {
+			return nil, dbError(err)
+		}
 	}
-	if _, err = tx.Exec(ctx, deleteMovieRatingsSQL, movieDTO.ID); err != nil {
+
+	return r.getMovieTx(ctx, tx, id)
+}
+
+// replaceMovieGenres rewrites movie_genres rows of the movie inside the transaction.
+func (r *Repository) replaceMovieGenres(ctx context.Context, tx pgx.Tx, movieID int, genreIDs []int) error {
+	if _, err := tx.Exec(ctx, deleteMovieGenresSQL, movieID); err != nil {
+		return dbError(err)
+	}
+	for _, gID := range genreIDs {
+		if _, err := tx.Exec(ctx, insertMovieGenreSQL, movieID, gID); err != nil {
+			return dbError(err)
+		}
+	}
+	return nil
+}
+
+// getMovieTx reads the movie with genres and credits inside the transaction.
+func (r *Repository) getMovieTx(ctx context.Context, tx pgx.Tx, movieID int) (*entities.Movie, error) {
+	dto := &entities.MovieDTO{}
+	if err := tx.QueryRow(ctx, getMovieSQL, movieID).Scan(
+		&dto.ID,
+		&dto.Title,
+		&dto.VideoURL,
+		&dto.CoverURL,
+		&dto.Description,
+		&dto.ReleaseDate,
+		&dto.DurationMin,
+		&dto.CreatedAt,
+		&dto.UpdatedAt,
+		&dto.Status,
+		&dto.PublishAt,
+		&dto.UnpublishAt,
+		&dto.GenreIDs,
+		&dto.GenreNames,
+		&dto.AverageRating,
+		&dto.Votes,
+		&dto.Histogram,
+	); err != nil {
+		return nil, dbError(err)
+	}
+	movie := dto.ToEntity()
+
+	if err := movieDetails(ctx, tx, movie); err != nil {
+		return nil, err
+	}
+	return movie, nil
+}
+
+// movieDetails fills the parts of the movie that are read only for a single movie:
+// credits, certifications and content descriptors.
+func movieDetails(ctx context.Context, q queryer, movie *entities.Movie) error {
+	credits, err := movieCredits(ctx, q, movie.ID)
+	if err != nil {
 		return err
 	}
-	if _, err = tx.Exec(ctx, deleteMovieCommentsSQL, movieDTO.ID); err != nil {
+	movie.Credits = credits
+
+	if movie.Certifications, err = movieCertifications(ctx, q, movie.ID); err != nil {
 		return err
 	}
-	if _, err = tx.Exec(ctx, deleteMovieSQL, movieDTO.ID); err != nil {
+	if movie.ContentDescriptors, err = movieDescriptors(ctx, q, movie.ID); err != nil {
 		return err
 	}
+	return nil
+}
 
+// DeleteMovie soft-deletes the movie: it is hidden from all reads, but its genres,
+// ratings and comments are kept until PurgeMovie.
+func (r *Repository) DeleteMovie(ctx context.Context, movie *entities.Movie) error {
+	tag, err := r.DB.Exec(ctx, softDeleteMovieSQL, movie.ID)
+	if err != nil {
+		return dbError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return dbError(pgx.ErrNoRows)
+	}
 	return nil
 }
 
-// ListRatings returns ratings for a movie with pagination.
+// RestoreMovie brings back a soft-deleted movie.
+func (r *Repository) RestoreMovie(ctx context.Context, movieID int) (*entities.Movie, error) {
+	var id int
+	if err := r.DB.QueryRow(ctx, restoreMovieSQL, movieID).Scan(&id); err != nil {
+		return nil, dbError(err)
+	}
+	return r.GetMovie(ctx, id)
+}
+
+// PurgeMovie permanently removes a soft-deleted movie with its genres, credits, ratings and comments.
+func (r *Repository) PurgeMovie(ctx context.Context, movieID int) (err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var deleted bool
+	if err = tx.QueryRow(ctx, lockMovieForPurgeSQL, movieID).Scan(&deleted); err != nil {
+		return dbError(err)
+	}
+	if !deleted {
+		return ErrMovieNotDeleted
+	}
+
+	if _, err = tx.Exec(ctx, deleteMovieGenresSQL, movieID); err != nil {
+		return dbError(err)
+	}
+	if _, err = tx.Exec(ctx, deleteMovieCreditsSQL, movieID); err != nil {
+		return dbError(err)
+	}
+	if _, err = tx.Exec(ctx, deleteMovieRatingsSQL, movieID); err != nil {
+		return dbError(err)
+	}
+	if _, err = tx.Exec(ctx, deleteMovieCommentsSQL, movieID); err != nil {
+		return dbError(err)
+	}
+	// movie_rating_stats is removed by ON DELETE CASCADE
+	_, err = tx.Exec(ctx, deleteMovieSQL, movieID)
+	return dbError(err)
+}
+
+// PurgeDeletedMovies purges up to limit movies soft-deleted before deletedBefore,
+// each in its own transaction, and returns how many were purged.
+func (r *Repository) PurgeDeletedMovies(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	rows, err := r.DB.Query(ctx, listPurgeableMoviesSQL, deletedBefore, limit)
+	if err != nil {
+		return 0, dbError(err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := r.PurgeMovie(ctx, id); err != nil {
+			// фильм могли восстановить между выборкой и очисткой
+			if errors.Is(err, ErrMovieNotDeleted) || errors.Is(err, errs.ErrNotFound) {
+				continue
+			}
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// ListRatings returns ratings of a movie, series or episode with pagination by page number or by page_token.
 func (r *Repository) ListRatings(ctx context.Context, request *entities.ListRatingsRequest) (*entities.ListRatingsResponse, error) {
 	if request.Page <= 0 {
 		request.Page = 1
@@ -277,78 +902,146 @@ func (r *Repository) ListRatings(ctx context.Context, request *entities.ListRati
 	}
 	offset := (request.Page - 1) * request.PerPage
 
-	rows, err := r.DB.Query(ctx, listRatingsSQL, request.MovieID, request.PerPage, offset)
+	q, targetID := queriesFor(request.Target)
+	scope := pageCursor{List: cursorListRatings, Target: q.targetKey(targetID)}
+
+	// лишняя строка в выборке означает, что есть следующая страница
+	var cursor *pageCursor
+	var rows pgx.Rows
+	var err error
+	if request.PageToken != "" {
+		if cursor, err = decodeCursor(request.PageToken); err != nil {
+			return nil, dbError(err)
+		}
+		if !cursor.sameList(scope) {
+			return nil, ErrInvalidPageToken
+		}
+		rows, err = r.DB.Query(ctx, q.listRatingsAfter, targetID, cursor.ID, request.PerPage+1)
+	} else {
+		rows, err = r.DB.Query(ctx, q.listRatings, targetID, request.PerPage+1, offset)
+	}
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer rows.Close()
 
 	ratings := make([]*entities.Rating, 0)
 	for rows.Next() {
-		ratingDTO := &entities.RatingDTO{}
-		if err := rows.Scan(
-			&ratingDTO.ID,
-			&ratingDTO.MovieID,
-			&ratingDTO.UserID,
-			&ratingDTO.Score,
-			&ratingDTO.CreatedAt,
-			&ratingDTO.UpdatedAt,
-		); err != nil {
-			return nil, err
+		rating, err := scanRating(rows)
+		if err != nil {
+			return nil, dbError(err)
 		}
-		ratings = append(ratings, ratingDTO.ToEntity())
+		ratings = append(ratings, rating)
 	}
 	if rows.Err() != nil {
-		return nil, rows.Err()
+		return nil, dbError(rows.Err())
 	}
 
-	var total int
-	if err := r.DB.QueryRow(ctx, countRatingsSQL, request.MovieID).Scan(&total); err != nil {
-		return nil, err
+	resp := &entities.ListRatingsResponse{Ratings: ratings}
+	if len(ratings) > request.PerPage {
+		resp.Ratings = ratings[:request.PerPage]
+		scope.ID = resp.Ratings[request.PerPage-1].ID
+		resp.NextPageToken = encodeCursor(scope)
+	}
+	if cursor == nil {
+		if err := r.DB.QueryRow(ctx, q.countRatings, targetID).Scan(&resp.Total); err != nil {
+			return nil, dbError(err)
+		}
 	}
 
-	return &entities.ListRatingsResponse{Ratings: ratings, Total: total}, nil
+	return resp, nil
 }
 
-// GetRating returns rating by movie and rating id.
-func (r *Repository) GetRating(ctx context.Context, movieID int, ratingID int) (*entities.Rating, error) {
-	ratingDTO := &entities.RatingDTO{}
-	if err := r.DB.QueryRow(ctx, getRatingSQL, movieID, ratingID).Scan(
-		&ratingDTO.ID,
-		&ratingDTO.MovieID,
-		&ratingDTO.UserID,
-		&ratingDTO.Score,
-		&ratingDTO.CreatedAt,
-		&ratingDTO.UpdatedAt,
-	); err != nil {
-		return nil, err
+// GetRating returns rating by target and rating id.
+func (r *Repository) GetRating(ctx context.Context, target entities.Target, ratingID int) (*entities.Rating, error) {
+	q, targetID := queriesFor(target)
+	rating, err := scanRating(r.DB.QueryRow(ctx, q.getRating, targetID, ratingID))
+	if err != nil {
+		return nil, dbError(err)
 	}
-	return ratingDTO.ToEntity(), nil
+	return rating, nil
 }
 
-// CreateRating inserts new rating for movie.
-func (r *Repository) CreateRating(ctx context.Context, rating *entities.Rating) (*entities.Rating, error) {
-	ratingDTO := rating.ToDTO()
+// GetRatingByUser returns rating of the target left by the user.
+func (r *Repository) GetRatingByUser(ctx context.Context, target entities.Target, userID int) (*entities.Rating, error) {
+	q, targetID := queriesFor(target)
+	rating, err := scanRating(r.DB.QueryRow(ctx, q.getUserRating, targetID, userID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return rating, nil
+}
 
-	if err := r.DB.QueryRow(ctx, insertRatingSQL, rating.MovieID, rating.UserID, rating.Score).Scan(
+// CreateRating inserts user's rating for a movie, series or episode or updates its score if the user
+// has already rated it. The second result is true when a new rating was inserted. Movie rating stats
+// are refreshed in the same transaction.
+func (r *Repository) CreateRating(ctx context.Context, rating *entities.Rating) (saved *entities.Rating, inserted bool, err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, false, dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	q, targetID := queriesFor(rating.Target())
+	if err = tx.QueryRow(ctx, q.lockTarget, targetID).Scan(&targetID); err != nil {
+		return nil, false, dbError(err)
+	}
+
+	ratingDTO := rating.ToDTO()
+	if err = tx.QueryRow(ctx, q.upsertRating, targetID, rating.UserID, rating.Score).Scan(
 		&ratingDTO.ID,
 		&ratingDTO.CreatedAt,
 		&ratingDTO.UpdatedAt,
+		&inserted,
 	); err != nil {
-		return nil, err
+		return nil, false, dbError(err)
 	}
 
-	return ratingDTO.ToEntity(), nil
+	if q.refreshStats != "" {
+		if _, err = tx.Exec(ctx, q.refreshStats, targetID); err != nil {
+			return nil, false, dbError(err)
+		}
+	}
+
+	return ratingDTO.ToEntity(), inserted, nil
 }
 
-// DeleteRating removes rating by id.
-func (r *Repository) DeleteRating(ctx context.Context, rating *entities.Rating) error {
-	ratingDTO := rating.ToDTO()
-	_, err := r.DB.Exec(ctx, deleteRatingSQL, ratingDTO.MovieID, ratingDTO.ID)
-	return err
+// DeleteRating removes rating by id and refreshes movie rating stats.
+func (r *Repository) DeleteRating(ctx context.Context, rating *entities.Rating) (err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	q, targetID := queriesFor(rating.Target())
+	if err = tx.QueryRow(ctx, q.lockTarget, targetID).Scan(&targetID); err != nil {
+		return dbError(err)
+	}
+	if _, err = tx.Exec(ctx, q.deleteRating, targetID, rating.ID); err != nil {
+		return dbError(err)
+	}
+	if q.refreshStats != "" {
+		_, err = tx.Exec(ctx, q.refreshStats, targetID)
+	}
+	return dbError(err)
 }
 
-// ListComments returns comments for a movie with pagination.
+// ListComments returns one level of the comment tree of a movie, series or episode (root comments or replies to
+// request.ParentID) with pagination by page number or by page_token. With request.WithReplies
+// every comment of the page carries its whole reply subtree.
 func (r *Repository) ListComments(ctx context.Context, request *entities.ListCommentsRequest) (*entities.ListCommentsResponse, error) {
 	if request.Page <= 0 {
 		request.Page = 1
@@ -357,83 +1050,265 @@ func (r *Repository) ListComments(ctx context.Context, request *entities.ListCom
 		request.PerPage = 10
 	}
 	offset := (request.Page - 1) * request.PerPage
+	q, targetID := queriesFor(request.Target)
+	scope := pageCursor{List: cursorListComments, Target: q.targetKey(targetID), Parent: request.ParentID}
 
-	rows, err := r.DB.Query(ctx, listCommentsSQL, request.MovieID, request.PerPage, offset)
+	// лишняя строка в выборке означает, что есть следующая страница
+	var cursor *pageCursor
+	var rows pgx.Rows
+	var err error
+	if request.PageToken != "" {
+		if cursor, err = decodeCursor(request.PageToken); err != nil {
+			return nil, dbError(err)
+		}
+		if !cursor.sameList(scope) {
+			return nil, ErrInvalidPageToken
+		}
+		rows, err = r.DB.Query(ctx, q.listCommentsAfter, targetID, request.ParentID, cursor.ID, request.PerPage+1)
+	} else {
+		rows, err = r.DB.Query(ctx, q.listComments, targetID, request.ParentID, request.PerPage+1, offset)
+	}
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
+	}
+	comments, err := collectComments(rows)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	resp := &entities.ListCommentsResponse{Comments: comments}
+	if len(comments) > request.PerPage {
+		resp.Comments = comments[:request.PerPage]
+		scope.ID = resp.Comments[request.PerPage-1].ID
+		resp.NextPageToken = encodeCursor(scope)
+	}
+	if cursor == nil {
+		if err := r.DB.QueryRow(ctx, q.countComments, targetID, request.ParentID).Scan(&resp.Total); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	if request.WithReplies && len(resp.Comments) > 0 {
+		if err := r.attachReplies(ctx, request.Target, resp.Comments); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// attachReplies loads the reply subtrees of the given comments and nests them into Replies.
+func (r *Repository) attachReplies(ctx context.Context, target entities.Target, comments []*entities.Comment) error {
+	byID := make(map[int]*entities.Comment, len(comments))
+	ids := make([]int, 0, len(comments))
+	for _, c := range comments {
+		byID[c.ID] = c
+		ids = append(ids, c.ID)
+	}
+
+	q, targetID := queriesFor(target)
+	rows, err := r.DB.Query(ctx, q.listCommentReplies, targetID, ids)
+	if err != nil {
+		return dbError(err)
+	}
+	replies, err := collectComments(rows)
+	if err != nil {
+		return dbError(err)
+	}
+	// ответы отсортированы по глубине, поэтому родитель уже лежит в byID
+	for _, reply := range replies {
+		if parent, ok := byID[reply.ParentID]; ok {
+			parent.Replies = append(parent.Replies, reply)
+		}
+		byID[reply.ID] = reply
+	}
+	return nil
+}
+
+// GetComment returns comment by target and comment id.
+func (r *Repository) GetComment(ctx context.Context, target entities.Target, commentID int) (*entities.Comment, error) {
+	q, targetID := queriesFor(target)
+	comment, err := scanComment(r.DB.QueryRow(ctx, q.getComment, targetID, commentID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return comment, nil
+}
+
+// CreateComment inserts new comment for a movie, series or episode. comment.ParentID and
+// comment.Depth must be filled by the caller for replies.
+func (r *Repository) CreateComment(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	commentDTO := comment.ToDTO()
+	q, targetID := queriesFor(comment.Target())
+
+	if err := r.DB.QueryRow(ctx, q.insertComment, targetID, comment.UserID, comment.Text, commentDTO.ParentID, comment.Depth).Scan(
+		&commentDTO.ID,
+		&commentDTO.CreatedAt,
+		&commentDTO.UpdatedAt,
+	); err != nil {
+		return nil, dbError(err)
+	}
+
+	return commentDTO.ToEntity(), nil
+}
+
+// UpdateComment replaces the comment text and marks it as edited.
+func (r *Repository) UpdateComment(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	q, targetID := queriesFor(comment.Target())
+	updated, err := scanComment(r.DB.QueryRow(ctx, q.updateComment, targetID, comment.ID, comment.Text))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return updated, nil
+}
+
+// DeleteComment removes comment by id; replies are removed by ON DELETE CASCADE.
+func (r *Repository) DeleteComment(ctx context.Context, comment *entities.Comment) error {
+	q, targetID := queriesFor(comment.Target())
+	_, err := r.DB.Exec(ctx, q.deleteComment, targetID, comment.ID)
+	return dbError(err)
+}
+
+// ListGenres returns all genres with the number of movies in each one.
+func (r *Repository) ListGenres(ctx context.Context) (*entities.ListGenresResponse, error) {
+	rows, err := r.DB.Query(ctx, listGenresSQL)
+	if err != nil {
+		return nil, dbError(err)
 	}
 	defer rows.Close()
 
-	comments := make([]*entities.Comment, 0)
+	genres := make([]*entities.Genre, 0)
 	for rows.Next() {
-		commentDTO := &entities.CommentDTO{}
+		genreDTO := &entities.GenreDTO{}
 		if err := rows.Scan(
-			&commentDTO.ID,
-			&commentDTO.MovieID,
-			&commentDTO.UserID,
-			&commentDTO.Text,
-			&commentDTO.CreatedAt,
-			&commentDTO.UpdatedAt,
+			&genreDTO.ID,
+			&genreDTO.Name,
+			&genreDTO.MovieCount,
 		); err != nil {
-			return nil, err
+			return nil, dbError(err)
 		}
-		comments = append(comments, commentDTO.ToEntity())
+		genres = append(genres, genreDTO.ToEntity())
 	}
 	if rows.Err() != nil {
-		return nil, rows.Err()
+		return nil, dbError(rows.Err())
 	}
 
-	var total int
-	if err := r.DB.QueryRow(ctx, countCommentsSQL, request.MovieID).Scan(&total); err != nil {
-		return nil, err
+	return &entities.ListGenresResponse{Genres: genres, Total: len(genres)}, nil
+}
+
+// CreateGenre inserts new genre.
+func (r *Repository) CreateGenre(ctx context.Context, genre *entities.Genre) (*entities.Genre, error) {
+	genreDTO := genre.ToDTO()
+	if err := r.DB.QueryRow(ctx, insertGenreSQL, genre.Name).Scan(&genreDTO.ID); err != nil {
+		return nil, dbError(err)
 	}
+	return genreDTO.ToEntity(), nil
+}
 
-	return &entities.ListCommentsResponse{Comments: comments, Total: total}, nil
+// UpdateGenre renames genre by id.
+func (r *Repository) UpdateGenre(ctx context.Context, genre *entities.Genre) (*entities.Genre, error) {
+	genreDTO := &entities.GenreDTO{}
+	if err := r.DB.QueryRow(ctx, updateGenreSQL, genre.ID, genre.Name).Scan(
+		&genreDTO.ID,
+		&genreDTO.Name,
+	); err != nil {
+		return nil, dbError(err)
+	}
+	return genreDTO.ToEntity(), nil
 }
 
-// GetComment returns comment by movie and comment id.
-func (r *Repository) GetComment(ctx context.Context, movieID int, commentID int) (*entities.Comment, error) {
-	commentDTO := &entities.CommentDTO{
-		ID:        new(int),
-		MovieID:   new(int),
-		UserID:    new(int),
-		Text:      new(string),
-		CreatedAt: new(time.Time),
-		UpdatedAt: new(time.Time),
+// DeleteGenre removes genre by id.
+// Without force it refuses with ErrGenreInUse when movies still reference the genre,
+// with force the movie_genres rows are removed in the same transaction.
+func (r *Repository) DeleteGenre(ctx context.Context, genre *entities.Genre, force bool) (err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return dbError(err)
 	}
-	if err := r.DB.QueryRow(ctx, getCommentSQL, movieID, commentID).Scan(
-		&commentDTO.ID,
-		&commentDTO.MovieID,
-		&commentDTO.UserID,
-		&commentDTO.Text,
-		&commentDTO.CreatedAt,
-		&commentDTO.UpdatedAt,
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var id int
+	if err = tx.QueryRow(ctx, lockGenreSQL, genre.ID).Scan(&id); err != nil {
+		return dbError(err)
+	}
+
+	var movies int
+	if err = tx.QueryRow(ctx, countGenreMoviesSQL, id).Scan(&movies); err != nil {
+		return dbError(err)
+	}
+	if movies > 0 {
+		if !force {
+			return ErrGenreInUse
+		}
+		if _, err = tx.Exec(ctx, deleteGenreMoviesSQL, id); err != nil {
+			return dbError(err)
+		}
+	}
+
+	if _, err = tx.Exec(ctx, deleteGenreSQL, id); err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+var _ InterfaceRepository = (*Repository)(nil)
+
+// scanRating reads a row selected with ratingColumnsSQL.
+func scanRating(row pgx.Row) (*entities.Rating, error) {
+	ratingDTO := &entities.RatingDTO{}
+	if err := row.Scan(
+		&ratingDTO.ID,
+		&ratingDTO.MovieID,
+		&ratingDTO.SeriesID,
+		&ratingDTO.EpisodeID,
+		&ratingDTO.UserID,
+		&ratingDTO.Score,
+		&ratingDTO.CreatedAt,
+		&ratingDTO.UpdatedAt,
 	); err != nil {
 		return nil, err
 	}
-	return commentDTO.ToEntity(), nil
+	return ratingDTO.ToEntity(), nil
 }
 
-// CreateComment inserts new comment for movie.
-func (r *Repository) CreateComment(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
-	commentDTO := comment.ToDTO()
-
-	if err := r.DB.QueryRow(ctx, insertCommentSQL, comment.MovieID, comment.UserID, comment.Text).Scan(
+// scanComment reads a row selected with commentColumnsSQL.
+func scanComment(row pgx.Row) (*entities.Comment, error) {
+	commentDTO := &entities.CommentDTO{}
+	if err := row.Scan(
 		&commentDTO.ID,
+		&commentDTO.MovieID,
+		&commentDTO.SeriesID,
+		&commentDTO.EpisodeID,
+		&commentDTO.ParentID,
+		&commentDTO.UserID,
+		&commentDTO.Text,
+		&commentDTO.Depth,
+		&commentDTO.Edited,
+		&commentDTO.ReplyCount,
 		&commentDTO.CreatedAt,
 		&commentDTO.UpdatedAt,
 	); err != nil {
 		return nil, err
 	}
-
 	return commentDTO.ToEntity(), nil
 }
 
-// DeleteComment removes comment by id.
-func (r *Repository) DeleteComment(ctx context.Context, comment *entities.Comment) error {
-	commentDTO := comment.ToDTO()
-	_, err := r.DB.Exec(ctx, deleteCommentSQL, commentDTO.MovieID, commentDTO.ID)
-	return err
-}
+// collectComments reads and closes rows selected with commentColumnsSQL.
+func collectComments(rows pgx.Rows) ([]*entities.Comment, error) {
+	defer rows.Close()
 
-var _ InterfaceRepository = (*Repository)(nil)
+	comments := make([]*entities.Comment, 0)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+)
+
+// ErrInvalidTransition возвращается, если фильм нельзя перевести в запрошенное состояние публикации из текущего.
+var ErrInvalidTransition = errs.New(errs.CodeConflict, "movie status transition is not allowed")
+
+const (
+	// блокировка строки сериализует конкурентные переходы и фоновую задачу
+	lockMovieStatusSQL   = `SELECT status FROM movies WHERE id=$1 AND deleted_at IS NULL FOR UPDATE`
+	updateMovieStatusSQL = `
+UPDATE movies
+SET status = $2, publish_at = $3, unpublish_at = $4, updated_at = now()
+WHERE id = $1;
+`
+
+	// $1 — текущее время; наступившая публикация и наступившее снятие с публикации
+	publishDueMoviesSQL = `
+UPDATE movies
+SET status = 'published', updated_at = now()
+WHERE status = 'scheduled' AND publish_at <= $1 AND deleted_at IS NULL;
+`
+	archiveDueMoviesSQL = `
+UPDATE movies
+SET status = 'archived', updated_at = now()
+WHERE status = 'published' AND unpublish_at <= $1 AND deleted_at IS NULL;
+`
+)
+
+// TransitionMovie moves the movie to movie.Status with movie.PublishAt and movie.UnpublishAt,
+// if its current status is one of from; otherwise ErrInvalidTransition is returned.
+func (r *Repository) TransitionMovie(ctx context.Context, movie *entities.Movie, from []string) (updated *entities.Movie, err error) {
+	tx, err := r.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		} else {
+			err = dbError(tx.Commit(ctx))
+		}
+	}()
+
+	var status string
+	if err = tx.QueryRow(ctx, lockMovieStatusSQL, movie.ID).Scan(&status); err != nil {
+		return nil, dbError(err)
+	}
+	if !slices.Contains(from, status) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, status, movie.Status)
+	}
+
+	if _, err = tx.Exec(ctx, updateMovieStatusSQL, movie.ID, movie.Status, movie.PublishAt, movie.UnpublishAt); err != nil {
+		return nil, dbError(err)
+	}
+	return r.getMovieTx(ctx, tx, movie.ID)
+}
+
+// ApplyMovieSchedule publishes scheduled movies whose publish_at has come and archives
+// movies whose unpublish_at has come. Returns the number of changed movies.
+func (r *Repository) ApplyMovieSchedule(ctx context.Context, now time.Time) (int, error) {
+	// снятие проверяется после публикации: фильм с обоими прошедшими сроками сразу архивируется
+	published, err := r.DB.Exec(ctx, publishDueMoviesSQL, now)
+	if err != nil {
+		return 0, dbError(err)
+	}
+	archived, err := r.DB.Exec(ctx, archiveDueMoviesSQL, now)
+	if err != nil {
+		return int(published.RowsAffected()), dbError(err)
+	}
+	return int(published.RowsAffected() + archived.RowsAffected()), nil
+}
@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"movieService/internal/entities"
+)
+
+const (
+	// порядок колонок совпадает со scanSeries; агрегаты оценок сериала не хранятся, а считаются при чтении
+	seriesColumnsSQL = `
+  se.id, se.title, se.cover_url, se.description, se.created_at, se.updated_at,
+  (SELECT COUNT(*) FROM seasons sn WHERE sn.series_id = se.id)                                   AS season_count,
+  (SELECT COUNT(*) FROM episodes e JOIN seasons sn ON sn.id = e.season_id WHERE sn.series_id = se.id) AS episode_count,
+  (SELECT COALESCE(AVG(r.score), 0)::NUMERIC(4,2)::float8 FROM ratings r WHERE r.series_id = se.id) AS average_rating,
+  (SELECT COUNT(*) FROM ratings r WHERE r.series_id = se.id)                                     AS votes,
+  ARRAY(SELECT COUNT(r.id)::int
+        FROM generate_series(1, 10) AS sc(score)
+        LEFT JOIN ratings r ON r.series_id = se.id AND r.score = sc.score
+        GROUP BY sc.score
+        ORDER BY sc.score)                                                                      AS histogram
+`
+	listSeriesSQL  = `SELECT ` + seriesColumnsSQL + ` FROM series se ORDER BY se.title, se.id LIMIT $1 OFFSET $2`
+	countSeriesSQL = `SELECT COUNT(*) FROM series`
+	getSeriesSQL   = `SELECT ` + seriesColumnsSQL + ` FROM series se WHERE se.id = $1`
+
+	listSeasonsSQL = `
+SELECT sn.id, sn.series_id, sn.number, sn.title,
+       (SELECT COUNT(*) FROM episodes e WHERE e.season_id = sn.id) AS episode_count
+FROM seasons sn
+WHERE sn.series_id = $1
+ORDER BY sn.number;
+`
+
+	// порядок колонок совпадает со scanEpisode; номер сезона и сериал берутся из seasons
+	episodeColumnsSQL = `
+  e.id, sn.series_id, e.season_id, sn.number, e.number, e.title, e.video_url, e.description,
+  e.release_date, e.duration_min, e.created_at, e.updated_at,
+  (SELECT COALESCE(AVG(r.score), 0)::NUMERIC(4,2)::float8 FROM ratings r WHERE r.episode_id = e.id) AS average_rating,
+  (SELECT COUNT(*) FROM ratings r WHERE r.episode_id = e.id)                                     AS votes,
+  ARRAY(SELECT COUNT(r.id)::int
+        FROM generate_series(1, 10) AS sc(score)
+        LEFT JOIN ratings r ON r.episode_id = e.id AND r.score = sc.score
+        GROUP BY sc.score
+        ORDER BY sc.score)                                                                      AS histogram
+`
+	// $2 — номер сезона, 0 — все сезоны
+	listEpisodesSQL = `
+SELECT ` + episodeColumnsSQL + `
+FROM episodes e
+JOIN seasons sn ON sn.id = e.season_id
+WHERE sn.series_id = $1 AND ($2::int = 0 OR sn.number = $2)
+ORDER BY sn.number, e.number;
+`
+	getEpisodeSQL = `SELECT ` + episodeColumnsSQL + ` FROM episodes e JOIN seasons sn ON sn.id = e.season_id WHERE e.id = $1`
+
+	// следующий по (номер сезона, номер эпизода) эпизод того же сериала
+	getNextEpisodeSQL = `
+WITH cur AS (
+    SELECT sn.series_id, sn.number AS season_number, e.number
+    FROM episodes e
+    JOIN seasons sn ON sn.id = e.season_id
+    WHERE e.id = $1
+)
+SELECT ` + episodeColumnsSQL + `
+FROM episodes e
+JOIN seasons sn ON sn.id = e.season_id
+JOIN cur ON cur.series_id = sn.series_id
+WHERE (sn.number, e.number) > (cur.season_number, cur.number)
+ORDER BY sn.number, e.number
+LIMIT 1;
+`
+	seriesExistsSQL = `SELECT id FROM series WHERE id=$1`
+)
+
+// ListSeries returns series ordered by title with season and episode counts.
+func (r *Repository) ListSeries(ctx context.Context, request *entities.ListSeriesRequest) (*entities.ListSeriesResponse, error) {
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+	if request.PerPage <= 0 {
+		request.PerPage = 10
+	}
+	offset := (request.Page - 1) * request.PerPage
+
+	rows, err := r.DB.Query(ctx, listSeriesSQL, request.PerPage, offset)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	series := make([]*entities.Series, 0)
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			return nil, dbError(err)
+		}
+		series = append(series, s)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+
+	resp := &entities.ListSeriesResponse{Series: series}
+	if err := r.DB.QueryRow(ctx, countSeriesSQL).Scan(&resp.Total); err != nil {
+		return nil, dbError(err)
+	}
+	return resp, nil
+}
+
+// GetSeries returns series by id together with its seasons.
+func (r *Repository) GetSeries(ctx context.Context, seriesID int) (*entities.Series, error) {
+	series, err := scanSeries(r.DB.QueryRow(ctx, getSeriesSQL, seriesID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	rows, err := r.DB.Query(ctx, listSeasonsSQL, seriesID)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	series.Seasons = make([]entities.Season, 0)
+	for rows.Next() {
+		var season entities.Season
+		if err := rows.Scan(
+			&season.ID,
+			&season.SeriesID,
+			&season.Number,
+			&season.Title,
+			&season.EpisodeCount,
+		); err != nil {
+			return nil, dbError(err)
+		}
+		series.Seasons = append(series.Seasons, season)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return series, nil
+}
+
+// ListEpisodes returns episodes of the series ordered by season and episode number.
+// season = 0 returns all seasons. Unknown series is reported as not found.
+func (r *Repository) ListEpisodes(ctx context.Context, seriesID int, season int) ([]*entities.Episode, error) {
+	if err := r.DB.QueryRow(ctx, seriesExistsSQL, seriesID).Scan(&seriesID); err != nil {
+		return nil, dbError(err)
+	}
+
+	rows, err := r.DB.Query(ctx, listEpisodesSQL, seriesID, season)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	episodes := make([]*entities.Episode, 0)
+	for rows.Next() {
+		episode, err := scanEpisode(rows)
+		if err != nil {
+			return nil, dbError(err)
+		}
+		episodes = append(episodes, episode)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+	return episodes, nil
+}
+
+// GetEpisode returns episode by id.
+func (r *Repository) GetEpisode(ctx context.Context, episodeID int) (*entities.Episode, error) {
+	episode, err := scanEpisode(r.DB.QueryRow(ctx, getEpisodeSQL, episodeID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return episode, nil
+}
+
+// GetNextEpisode returns the episode that follows episodeID in the same series: the next one
+// in the season or the first one of the next season. The last episode yields not found.
+func (r *Repository) GetNextEpisode(ctx context.Context, episodeID int) (*entities.Episode, error) {
+	episode, err := scanEpisode(r.DB.QueryRow(ctx, getNextEpisodeSQL, episodeID))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return episode, nil
+}
+
+// scanSeries reads a row selected with seriesColumnsSQL.
+func scanSeries(row pgx.Row) (*entities.Series, error) {
+	s := &entities.Series{}
+	if err := row.Scan(
+		&s.ID,
+		&s.Title,
+		&s.CoverURL,
+		&s.Description,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+		&s.SeasonCount,
+		&s.EpisodeCount,
+		&s.RatingStats.Average,
+		&s.RatingStats.Votes,
+		&s.RatingStats.Histogram,
+	); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// scanEpisode reads a row selected with episodeColumnsSQL.
+func scanEpisode(row pgx.Row) (*entities.Episode, error) {
+	e := &entities.Episode{}
+	if err := row.Scan(
+		&e.ID,
+		&e.SeriesID,
+		&e.SeasonID,
+		&e.SeasonNumber,
+		&e.Number,
+		&e.Title,
+		&e.VideoURL,
+		&e.Description,
+		&e.ReleaseDate,
+		&e.DurationMin,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.RatingStats.Average,
+		&e.RatingStats.Votes,
+		&e.RatingStats.Histogram,
+	); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"movieService/internal/errs"
+)
+
+// newIntegrationRepository подключается к базе из TEST_DATABASE_URL с применёнными миграциями;
+// без переменной тест пропускается.
+func newIntegrationRepository(t *testing.T) *Repository {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return &Repository{ctx: context.Background(), log: zap.NewNop(), DB: pool}
+}
+
+func TestGetNextEpisodeAcrossSeasons(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+
+	var seriesID int
+	require.NoError(t, repo.DB.QueryRow(ctx, `INSERT INTO series (title) VALUES ('next episode test') RETURNING id`).Scan(&seriesID))
+	t.Cleanup(func() {
+		_, _ = repo.DB.Exec(context.Background(), `DELETE FROM series WHERE id=$1`, seriesID)
+	})
+
+	// сезоны вставлены не по порядку номеров, эпизоды второго сезона — тоже
+	episodes := map[[2]int]int{}
+	for _, season := range []int{2, 1} {
+		var seasonID int
+		require.NoError(t, repo.DB.QueryRow(ctx,
+			`INSERT INTO seasons (series_id, number) VALUES ($1, $2) RETURNING id`, seriesID, season).Scan(&seasonID))
+		for _, number := range []int{2, 1} {
+			var episodeID int
+			require.NoError(t, repo.DB.QueryRow(ctx, `
+INSERT INTO episodes (season_id, number, title, video_url, release_date, duration_min)
+VALUES ($1, $2, 'episode', 'https://cdn.example.com/e.mp4', '2020-01-01', 40) RETURNING id`,
+				seasonID, number).Scan(&episodeID))
+			episodes[[2]int{season, number}] = episodeID
+		}
+	}
+
+	cases := []struct {
+		from, want [2]int
+	}{
+		{[2]int{1, 1}, [2]int{1, 2}},
+		// последний эпизод сезона ведёт к первому эпизоду следующего сезона
+		{[2]int{1, 2}, [2]int{2, 1}},
+		{[2]int{2, 1}, [2]int{2, 2}},
+	}
+	for _, c := range cases {
+		next, err := repo.GetNextEpisode(ctx, episodes[c.from])
+		require.NoError(t, err, c.from)
+		assert.Equal(t, episodes[c.want], next.ID, c.from)
+		assert.Equal(t, c.want[0], next.SeasonNumber, c.from)
+		assert.Equal(t, c.want[1], next.Number, c.from)
+		assert.Equal(t, seriesID, next.SeriesID, c.from)
+	}
+
+	// за последним эпизодом сериала ничего нет
+	_, err := repo.GetNextEpisode(ctx, episodes[[2]int{2, 2}])
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+
+	"movieService/internal/entities"
+)
+
+const (
+	// для оценок и комментариев сериала и эпизода: $1 — ID объекта
+	liveSeriesSQL  = `EXISTS (SELECT 1 FROM series WHERE id = $1)`
+	liveEpisodeSQL = `EXISTS (SELECT 1 FROM episodes WHERE id = $1)`
+
+	// блокировка строки объекта сериализует конкурентные оценки; нет строки — 404 вместо нарушения FK
+	lockSeriesRatingsSQL  = `SELECT id FROM series WHERE id=$1 FOR NO KEY UPDATE`
+	lockEpisodeRatingsSQL = `SELECT id FROM episodes WHERE id=$1 FOR NO KEY UPDATE`
+)
+
+// targetQueries holds rating and comment queries for one kind of catalog object.
+type targetQueries struct {
+	// column is the target column of ratings and comments
+	column string
+
+	listRatings      string
+	listRatingsAfter string
+	countRatings     string
+	getRating        string
+	getUserRating    string
+	upsertRating     string
+	deleteRating     string
+	lockTarget       string
+	// refreshStats recalculates stored rating stats; empty when stats are computed on read
+	refreshStats string
+
+	listComments       string
+	listCommentsAfter  string
+	countComments      string
+	getComment         string
+	insertComment      string
+	updateComment      string
+	deleteComment      string
+	listCommentReplies string
+}
+
+var (
+	movieQueries   = newTargetQueries("movie_id", liveMovieSQL, lockMovieRatingsSQL, refreshRatingStatsSQL)
+	seriesQueries  = newTargetQueries("series_id", liveSeriesSQL, lockSeriesRatingsSQL, "")
+	episodeQueries = newTargetQueries("episode_id", liveEpisodeSQL, lockEpisodeRatingsSQL, "")
+)
+
+// newTargetQueries renders the rating and comment query templates for the target column.
+func newTargetQueries(column, live, lock, refreshStats string) *targetQueries {
+	render := func(query string) string {
+		return fmt.Sprintf(query, column, live)
+	}
+	return &targetQueries{
+		column: column,
+
+		listRatings:      render(listRatingsSQL),
+		listRatingsAfter: render(listRatingsAfterSQL),
+		countRatings:     render(countRatingsSQL),
+		getRating:        render(getRatingSQL),
+		getUserRating:    render(getUserRatingSQL),
+		upsertRating:     render(upsertRatingSQL),
+		deleteRating:     render(deleteRatingSQL),
+		lockTarget:       lock,
+		refreshStats:     refreshStats,
+
+		listComments:       render(listCommentsSQL),
+		listCommentsAfter:  render(listCommentsAfterSQL),
+		countComments:      render(countCommentsSQL),
+		getComment:         render(getCommentSQL),
+		insertComment:      render(insertCommentSQL),
+		updateComment:      render(updateCommentSQL),
+		deleteComment:      render(deleteCommentSQL),
+		listCommentReplies: render(listCommentRepliesSQL),
+	}
+}
+
+// queriesFor picks the queries for the target and returns the target id bound to $1.
+// A target without series and episode is a movie.
+func queriesFor(target entities.Target) (*targetQueries, int) {
+	switch {
+	case target.EpisodeID != 0:
+		return episodeQueries, target.EpisodeID
+	case target.SeriesID != 0:
+		return seriesQueries, target.SeriesID
+	default:
+		return movieQueries, target.MovieID
+	}
+}
+
+// targetKey identifies the target in page tokens, e.g. "movie_id:7".
+func (q *targetQueries) targetKey(targetID int) string {
+	return q.column + ":" + strconv.Itoa(targetID)
+}
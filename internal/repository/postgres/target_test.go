@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"movieService/internal/entities"
+)
+
+func TestQueriesFor(t *testing.T) {
+	cases := []struct {
+		name    string
+		target  entities.Target
+		want    *targetQueries
+		wantID  int
+		column  string
+		live    string
+		refresh bool
+	}{
+		{"movie", entities.Target{MovieID: 7}, movieQueries, 7, "movie_id", liveMovieSQL, true},
+		{"series", entities.Target{SeriesID: 8}, seriesQueries, 8, "series_id", liveSeriesSQL, false},
+		{"episode", entities.Target{EpisodeID: 9}, episodeQueries, 9, "episode_id", liveEpisodeSQL, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			q, id := queriesFor(c.target)
+			assert.Same(t, c.want, q)
+			assert.Equal(t, c.wantID, id)
+
+			// шаблоны отрендерены для колонки объекта и проверяют, что объект существует
+			assert.Contains(t, q.listRatings, "WHERE "+c.column+"=$1 AND "+c.live)
+			assert.Contains(t, q.listComments, "c."+c.column+"=$1 AND "+c.live)
+			assert.Contains(t, q.upsertRating, "ON CONFLICT ("+c.column+", user_id)")
+			assert.Contains(t, q.insertComment, "INSERT INTO comments ("+c.column+",")
+			assert.NotContains(t, q.listRatings+q.getComment+q.updateComment, "%!")
+			// агрегаты хранятся только для фильмов, у сериалов и эпизодов они считаются при чтении
+			assert.Equal(t, c.refresh, q.refreshStats != "")
+			assert.True(t, strings.HasPrefix(q.lockTarget, "SELECT id FROM "))
+		})
+	}
+}
+
+func TestQueriesForDefaultsToMovie(t *testing.T) {
+	q, id := queriesFor(entities.Target{})
+	assert.Same(t, movieQueries, q)
+	assert.Zero(t, id)
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"movieService/internal/logger"
+)
+
+// queryTracer is a pgx.QueryTracer that opens a span for every query,
+// so slow SQL is visible as a child of the usecase span, and logs failed queries
+// with the request-scoped logger.
+type queryTracer struct {
+	log    *zap.Logger
+	tracer trace.Tracer
+	dbName string
+}
+
+type queryStatementKey struct{}
+
+var _ pgx.QueryTracer = (*queryTracer)(nil)
+
+func newQueryTracer(log *zap.Logger, dbName string) *queryTracer {
+	return &queryTracer{
+		log:    log,
+		tracer: otel.Tracer("movieService/internal/repository/postgres"),
+		dbName: dbName,
+	}
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	statement := strings.Join(strings.Fields(data.SQL), " ")
+	operation, _, _ := strings.Cut(statement, " ")
+	operation = strings.ToUpper(operation)
+
+	ctx, _ = t.tracer.Start(ctx, operation+" "+t.dbName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBNamespace(t.dbName),
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(statement),
+		),
+	)
+	return context.WithValue(ctx, queryStatementKey{}, statement)
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		statement, _ := ctx.Value(queryStatementKey{}).(string)
+		logger.FromContext(ctx, t.log).Warn("postgres query failed",
+			zap.String("statement", statement),
+			zap.Error(data.Err),
+		)
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+}
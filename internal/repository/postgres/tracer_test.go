@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newRecordedTracer возвращает трейсер запросов, спаны которого пишутся в recorder.
+func newRecordedTracer() (*queryTracer, *tracetest.SpanRecorder, *observer.ObservedLogs) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	core, logs := observer.New(zapcore.WarnLevel)
+	return &queryTracer{log: zap.New(core), tracer: provider.Tracer("test"), dbName: "movies"}, recorder, logs
+}
+
+// traceQuery прогоняет запрос через трейсер и возвращает единственный записанный спан.
+func traceQuery(t *testing.T, tracer *queryTracer, recorder *tracetest.SpanRecorder, sql string, end pgx.TraceQueryEndData) sdktrace.ReadOnlySpan {
+	t.Helper()
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+	tracer.TraceQueryEnd(ctx, nil, end)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	return spans[0]
+}
+
+func TestQueryTracerNamesSpanByOperation(t *testing.T) {
+	tracer, recorder, logs := newRecordedTracer()
+
+	span := traceQuery(t, tracer, recorder, "\n  update movies\n  SET title = $2\nWHERE id = $1",
+		pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("UPDATE 3")})
+
+	assert.Equal(t, "UPDATE movies", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	assert.Contains(t, span.Attributes(), attribute.String("db.operation.name", "UPDATE"))
+	assert.Contains(t, span.Attributes(), attribute.String("db.namespace", "movies"))
+	assert.Contains(t, span.Attributes(), attribute.String("db.query.text", "update movies SET title = $2 WHERE id = $1"))
+	assert.Contains(t, span.Attributes(), attribute.Int64("db.rows_affected", 3))
+	assert.Zero(t, logs.Len())
+}
+
+func TestQueryTracerMarksFailedQuery(t *testing.T) {
+	tracer, recorder, logs := newRecordedTracer()
+	boom := errors.New("relation does not exist")
+
+	span := traceQuery(t, tracer, recorder, "SELECT 1 FROM missing", pgx.TraceQueryEndData{Err: boom})
+
+	assert.Equal(t, "SELECT movies", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, boom.Error(), span.Status().Description)
+	require.Len(t, span.Events(), 1)
+	assert.Equal(t, "exception", span.Events()[0].Name)
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "postgres query failed", entry.Message)
+	assert.Equal(t, "SELECT 1 FROM missing", entry.ContextMap()["statement"])
+}
+
+func TestQueryTracerIgnoresNoRows(t *testing.T) {
+	tracer, recorder, logs := newRecordedTracer()
+
+	span := traceQuery(t, tracer, recorder, "SELECT id FROM movies WHERE id = $1", pgx.TraceQueryEndData{Err: pgx.ErrNoRows})
+
+	// «не найдено» — обычный ответ, а не сбой запроса
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	assert.Empty(t, span.Events())
+	assert.Zero(t, logs.Len())
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"movieService/internal/entities"
+)
+
+const (
+	// порядок колонок совпадает со scanMovieTranslation
+	movieTranslationColumnsSQL = `movie_id, locale, title, description, created_at, updated_at`
+
+	movieExistsSQL = `SELECT id FROM movies WHERE id=$1 AND deleted_at IS NULL`
+	// переводы мягко удалённого фильма не отдаются, как и его оценки и комментарии
+	listMovieTranslationsSQL = `SELECT ` + movieTranslationColumnsSQL + ` FROM movie_translations WHERE movie_id=$1 AND ` + liveMovieSQL + ` ORDER BY locale`
+	// перевод мягко удалённого фильма не сохраняется: строка не вставится, и вернётся ErrNoRows
+	upsertMovieTranslationSQL = `
+INSERT INTO movie_translations (movie_id, locale, title, description)
+SELECT $1, $2, $3, $4 WHERE ` + liveMovieSQL + `
+ON CONFLICT (movie_id, locale) DO UPDATE
+SET title = EXCLUDED.title, description = EXCLUDED.description, updated_at = now()
+RETURNING ` + movieTranslationColumnsSQL
+	deleteMovieTranslationSQL = `DELETE FROM movie_translations WHERE movie_id=$1 AND locale=$2`
+
+	// для каждого фильма $1 — перевод на первую локаль из $2, для которой он есть
+	bestMovieTranslationsSQL = `
+SELECT DISTINCT ON (movie_id) movie_id, locale, title, description
+FROM movie_translations
+WHERE movie_id = ANY($1) AND locale = ANY($2)
+ORDER BY movie_id, array_position($2, locale);
+`
+)
+
+// ListMovieTranslations returns all translations of a live movie ordered by locale.
+// A missing or soft-deleted movie yields not found rather than an empty list.
+func (r *Repository) ListMovieTranslations(ctx context.Context, movieID int) ([]*entities.MovieTranslation, error) {
+	rows, err := r.DB.Query(ctx, listMovieTranslationsSQL, movieID)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	translations := make([]*entities.MovieTranslation, 0)
+	for rows.Next() {
+		t, err := scanMovieTranslation(rows)
+		if err != nil {
+			return nil, dbError(err)
+		}
+		translations = append(translations, t)
+	}
+	if rows.Err() != nil {
+		return nil, dbError(rows.Err())
+	}
+
+	// пустой список отличаем от фильма, которого нет
+	if len(translations) == 0 {
+		if err := r.DB.QueryRow(ctx, movieExistsSQL, movieID).Scan(&movieID); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	return translations, nil
+}
+
+// SetMovieTranslation creates or replaces the translation of a live movie into translation.Locale.
+func (r *Repository) SetMovieTranslation(ctx context.Context, translation *entities.MovieTranslation) (*entities.MovieTranslation, error) {
+	saved, err := scanMovieTranslation(r.DB.QueryRow(ctx, upsertMovieTranslationSQL,
+		translation.MovieID,
+		translation.Locale,
+		translation.Title,
+		translation.Description,
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return saved, nil
+}
+
+// DeleteMovieTranslation removes the translation of a movie into locale.
+func (r *Repository) DeleteMovieTranslation(ctx context.Context, movieID int, locale string) error {
+	tag, err := r.DB.Exec(ctx, deleteMovieTranslationSQL, movieID, locale)
+	if err != nil {
+		return dbError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return dbError(pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// TranslateMovies replaces Title and Description of every movie with its translation
+// into the first of locales that has one, and sets Locale accordingly.
+// Movies without a matching translation are left untouched.
+func (r *Repository) TranslateMovies(ctx context.Context, movies []*entities.Movie, locales []string) error {
+	if len(movies) == 0 || len(locales) == 0 {
+		return nil
+	}
+	byID := make(map[int][]*entities.Movie, len(movies))
+	ids := make([]int, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	rows, err := r.DB.Query(ctx, bestMovieTranslationsSQL, ids, locales)
+	if err != nil {
+		return dbError(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var t entities.MovieTranslation
+		if err := rows.Scan(&t.MovieID, &t.Locale, &t.Title, &t.Description); err != nil {
+			return dbError(err)
+		}
+		for _, m := range byID[t.MovieID] {
+			m.Title, m.Description, m.Locale = t.Title, t.Description, t.Locale
+		}
+	}
+	return dbError(rows.Err())
+}
+
+func scanMovieTranslation(row pgx.Row) (*entities.MovieTranslation, error) {
+	var t entities.MovieTranslation
+	if err := row.Scan(&t.MovieID, &t.Locale, &t.Title, &t.Description, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,99 @@
+// Package tracing настраивает OpenTelemetry: провайдер трейсов, экспортёр и W3C-пропагацию контекста.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+)
+
+// Экспортёры, допустимые в Tracing.exporter.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Provider — глобальный провайдер трейсов приложения; OnStop дописывает накопленные спаны.
+type Provider struct {
+	log      *zap.Logger
+	provider *sdktrace.TracerProvider
+}
+
+// New создаёт провайдер по конфигу и регистрирует его глобально вместе с пропагатором
+// W3C Trace Context и Baggage, поэтому otelgin, otelgrpc и трейсер pgx подхватывают его сами.
+func New(log *zap.Logger, cfg *config.Config) (*Provider, error) {
+	tc := cfg.Tracing
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := newExporter(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(tc.ServiceName),
+		semconv.ServiceVersion(cfg.Server.AppVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(tc.SampleRatio))),
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	log.Info("tracing configured", zap.String("exporter", tc.Exporter), zap.Float64("sample_ratio", tc.SampleRatio))
+	return &Provider{log: log, provider: provider}, nil
+}
+
+// newExporter возвращает экспортёр по имени; для none — nil: спаны создаются
+// (trace id попадает в заголовки и логи), но никуда не отправляются.
+func newExporter(tc config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch tc.Exporter {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(tc.Endpoint)}
+		if tc.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// соединение устанавливается лениво, недоступный коллектор не мешает запуску
+		return otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", tc.Exporter)
+	}
+}
+
+func (p *Provider) OnStart(_ context.Context) error { return nil }
+
+// OnStop отправляет накопленные спаны и останавливает экспортёр.
+func (p *Provider) OnStop(ctx context.Context) error {
+	if err := p.provider.Shutdown(ctx); err != nil {
+		p.log.Warn("tracing shutdown failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+	"movieService/internal/config"
+)
+
+func TestNewExporter(t *testing.T) {
+	cases := []struct {
+		name     string
+		cfg      config.TracingConfig
+		wantType any
+		wantErr  bool
+	}{
+		{name: "empty means none", cfg: config.TracingConfig{}},
+		{name: "none", cfg: config.TracingConfig{Exporter: ExporterNone}},
+		{name: "stdout", cfg: config.TracingConfig{Exporter: ExporterStdout}, wantType: &stdouttrace.Exporter{}},
+		// коллектор недоступен, но соединение ленивое — экспортёр создаётся
+		{name: "otlp", cfg: config.TracingConfig{Exporter: ExporterOTLP, Endpoint: "127.0.0.1:1", Insecure: true}, wantType: &otlptrace.Exporter{}},
+		{name: "unknown", cfg: config.TracingConfig{Exporter: "jaeger"}, wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			exporter, err := newExporter(c.cfg)
+			if c.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `unknown tracing exporter "jaeger"`)
+				return
+			}
+			require.NoError(t, err)
+			if c.wantType == nil {
+				assert.Nil(t, exporter)
+				return
+			}
+			assert.IsType(t, c.wantType, exporter)
+			assert.NoError(t, exporter.Shutdown(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"movieService/internal/entities"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// SetMovieCertifications полностью заменяет возрастные рейтинги фильма по странам и описания его содержимого.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма, рейтингами (страна, обозначение, минимальный возраст) и описаниями содержимого.
+//
+// Возвращает:
+//   - Movie: DTO с фильмом, новыми рейтингами и описаниями.
+//   - error: ошибку валидации, ошибку, если фильм не найден, или сбой БД.
+func (uc *Usecase) SetMovieCertifications(ctx context.Context, req *protos.SetMovieCertificationsRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.SetMovieCertifications: входной запрос",
+		zap.Int32("movie_id", req.GetMovieId()),
+		zap.Int("certifications", len(req.GetCertifications())),
+		zap.Strings("content_descriptors", req.GetContentDescriptors()),
+	)
+
+	// 1. Маппим Protobuf → Entity; код страны приводится к верхнему регистру
+	certifications := make([]entities.Certification, 0, len(req.GetCertifications()))
+	for _, c := range req.GetCertifications() {
+		certifications = append(certifications, entities.Certification{
+			Country:       strings.ToUpper(strings.TrimSpace(c.GetCountry())),
+			Certification: strings.TrimSpace(c.GetCertification()),
+			MinAge:        int(c.GetMinAge()),
+		})
+	}
+	descriptors := req.GetContentDescriptors()
+	if err := validateCertifications(certifications, descriptors); err != nil {
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий
+	updated, err := uc.repo.SetMovieCertifications(ctx, int(req.GetMovieId()), certifications, descriptors)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.SetMovieCertifications: ошибка сохранения рейтингов", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.SetMovieCertifications: рейтинги успешно сохранены",
+		zap.Int("movie_id", updated.ID),
+		zap.Int("certifications", len(updated.Certifications)),
+	)
+	return movieToProto(updated), nil
+}
+
+// ageFilter собирает возрастной фильтр из параметров запроса и токена детского профиля.
+// Ограничение из токена нельзя ослабить: max_age берётся не больше, чем в токене, а страна
+// для детского профиля берётся только из токена — иначе можно выбрать страну с самыми мягкими
+// рейтингами. Страна из токена без max_age тоже заменяет страну из запроса.
+// Пустая страна — страна по умолчанию из конфига.
+func (uc *Usecase) ageFilter(ctx context.Context, country string, maxAge *int32, certifications []string) (entities.AgeFilter, error) {
+	filter := entities.AgeFilter{Country: strings.ToUpper(strings.TrimSpace(country))}
+	if maxAge != nil {
+		age := int(*maxAge)
+		filter.MaxAge = &age
+	}
+	for _, c := range certifications {
+		filter.Certifications = append(filter.Certifications, strings.TrimSpace(c))
+	}
+	if err := validateAgeFilter(filter); err != nil {
+		return entities.AgeFilter{}, err
+	}
+
+	if claims, ok := JWT.ClaimsFromContext(ctx); ok {
+		if claims.Country != "" || claims.MaxAge != nil {
+			filter.Country = strings.ToUpper(claims.Country)
+		}
+		if claims.MaxAge != nil && (filter.MaxAge == nil || int(*claims.MaxAge) < *filter.MaxAge) {
+			age := int(*claims.MaxAge)
+			filter.MaxAge = &age
+		}
+	}
+	if filter.Country == "" {
+		filter.Country = strings.ToUpper(uc.cfg.Certification.DefaultCountry)
+	}
+	return filter, nil
+}
+
+// certificationToProto маппит возрастной рейтинг фильма в Protobuf.
+func certificationToProto(c entities.Certification) *protos.Certification {
+	return &protos.Certification{
+		Country:       c.Country,
+		Certification: c.Certification,
+		MinAge:        int32(c.MinAge),
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	JWT "movieService/pkg/jwt"
+)
+
+func TestAgeFilter(t *testing.T) {
+	uc := newTestUsecase(nil)
+	uc.cfg.Certification.DefaultCountry = "ru"
+	age := func(v int32) *int32 { return &v }
+
+	cases := []struct {
+		name        string
+		claims      *JWT.Claims
+		country     string
+		maxAge      *int32
+		wantCountry string
+		wantMaxAge  *int
+	}{
+		{name: "anonymous picks the country", country: "us", maxAge: age(13), wantCountry: "US", wantMaxAge: ptr(13)},
+		{name: "anonymous without country", wantCountry: "RU"},
+		{name: "adult picks the country", claims: &JWT.Claims{UserID: 1}, country: "US", wantCountry: "US"},
+		{name: "country from the adult token", claims: &JWT.Claims{UserID: 1, Country: "de"}, country: "US", wantCountry: "DE"},
+		{
+			// ребёнок не может выбрать страну с более мягкими рейтингами
+			name:   "kid without country in the token",
+			claims: &JWT.Claims{UserID: 2, MaxAge: age(12)}, country: "US",
+			wantCountry: "RU", wantMaxAge: ptr(12),
+		},
+		{
+			name:   "kid with country in the token",
+			claims: &JWT.Claims{UserID: 2, MaxAge: age(12), Country: "fr"}, country: "US",
+			wantCountry: "FR", wantMaxAge: ptr(12),
+		},
+		{
+			name:   "kid can only tighten max_age",
+			claims: &JWT.Claims{UserID: 2, MaxAge: age(12)}, maxAge: age(16),
+			wantCountry: "RU", wantMaxAge: ptr(12),
+		},
+		{
+			name:   "kid asks for a stricter max_age",
+			claims: &JWT.Claims{UserID: 2, MaxAge: age(12)}, maxAge: age(6),
+			wantCountry: "RU", wantMaxAge: ptr(6),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			if c.claims != nil {
+				ctx = JWT.WithClaims(ctx, c.claims)
+			}
+			filter, err := uc.ageFilter(ctx, c.country, c.maxAge, nil)
+			require.NoError(t, err)
+			assert.Equal(t, c.wantCountry, filter.Country)
+			assert.Equal(t, c.wantMaxAge, filter.MaxAge)
+		})
+	}
+}
+
+func TestAgeFilterValidatesRequest(t *testing.T) {
+	uc := newTestUsecase(nil)
+	age := int32(99)
+
+	_, err := uc.ageFilter(context.Background(), "USA", &age, []string{" "})
+	assert.Equal(t, []string{"country:format", "max_age:range", "certifications[0]:required"}, violated(t, err))
+}
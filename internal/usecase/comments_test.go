@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/repository/postgres"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// commentsRepository хранит комментарии в памяти и, как SQL-запросы репозитория,
+// ищет комментарий только среди комментариев запрошенного объекта.
+type commentsRepository struct {
+	postgres.InterfaceRepository
+
+	comments map[int]*entities.Comment
+	created  []*entities.Comment
+	updated  []*entities.Comment
+}
+
+func newCommentsRepository(comments ...*entities.Comment) *commentsRepository {
+	r := &commentsRepository{comments: make(map[int]*entities.Comment)}
+	for _, c := range comments {
+		r.comments[c.ID] = c
+	}
+	return r
+}
+
+func (r *commentsRepository) GetComment(_ context.Context, target entities.Target, commentID int) (*entities.Comment, error) {
+	c, ok := r.comments[commentID]
+	if !ok || c.Target() != target {
+		return nil, errs.NotFound("not found")
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (r *commentsRepository) CreateComment(_ context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	comment.ID = 100 + len(r.created)
+	r.created = append(r.created, comment)
+	return comment, nil
+}
+
+func (r *commentsRepository) UpdateComment(_ context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	comment.Edited = true
+	r.updated = append(r.updated, comment)
+	return comment, nil
+}
+
+// GetMovie отдаёт опубликованный фильм: видимость фильмов проверяется в publication_test.go.
+func (r *commentsRepository) GetMovie(_ context.Context, movieID int) (*entities.Movie, error) {
+	return &entities.Movie{ID: movieID, Status: entities.MovieStatusPublished}, nil
+}
+
+func userContext(userID int32, role string) context.Context {
+	return JWT.WithClaims(context.Background(), &JWT.Claims{UserID: userID, Role: role})
+}
+
+func TestCreateCommentReply(t *testing.T) {
+	repo := newCommentsRepository(&entities.Comment{ID: 1, MovieID: 7, UserID: 2, Depth: 1})
+	uc := newTestUsecase(repo)
+
+	resp, err := uc.CreateComment(userContext(5, JWT.RoleViewer), &protos.CreateCommentRequest{MovieId: 7, ParentId: 1, Text: "agreed"})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1, resp.GetComment().GetParentId())
+	assert.EqualValues(t, 2, resp.GetComment().GetDepth())
+	assert.EqualValues(t, 5, resp.GetComment().GetUserId())
+	require.Len(t, repo.created, 1)
+}
+
+func TestCreateCommentDepthOverflow(t *testing.T) {
+	// commentMaxDepth = 2: ответ на комментарий глубины 2 оказался бы на третьем уровне
+	repo := newCommentsRepository(&entities.Comment{ID: 1, MovieID: 7, UserID: 2, Depth: 2})
+	uc := newTestUsecase(repo)
+
+	_, err := uc.CreateComment(userContext(5, JWT.RoleViewer), &protos.CreateCommentRequest{MovieId: 7, ParentId: 1, Text: "too deep"})
+
+	assert.Equal(t, []string{"parent_id:max_depth"}, violated(t, err))
+	assert.Empty(t, repo.created)
+}
+
+func TestCreateCommentParentOfAnotherTarget(t *testing.T) {
+	repo := newCommentsRepository(
+		&entities.Comment{ID: 1, MovieID: 8, UserID: 2},
+		&entities.Comment{ID: 2, SeriesID: 7, UserID: 2},
+	)
+	uc := newTestUsecase(repo)
+	ctx := userContext(5, JWT.RoleViewer)
+
+	// комментарий другого фильма и комментарий сериала с тем же ID не находятся
+	for _, parentID := range []int32{1, 2} {
+		_, err := uc.CreateComment(ctx, &protos.CreateCommentRequest{MovieId: 7, ParentId: parentID, Text: "reply"})
+		assert.ErrorIs(t, err, errs.ErrNotFound, parentID)
+	}
+	assert.Empty(t, repo.created)
+}
+
+func TestValidateCommentParentTarget(t *testing.T) {
+	uc := newTestUsecase(nil)
+	parent := &entities.Comment{ID: 1, SeriesID: 7}
+
+	assert.NoError(t, uc.validateCommentParent(entities.Target{SeriesID: 7}, parent))
+	assert.Equal(t, []string{"parent_id:same_target"}, violated(t, uc.validateCommentParent(entities.Target{MovieID: 7}, parent)))
+}
+
+func TestEditCommentOnlyByAuthor(t *testing.T) {
+	repo := newCommentsRepository(&entities.Comment{ID: 1, MovieID: 7, UserID: 2, Text: "first"})
+	uc := newTestUsecase(repo)
+	req := &protos.EditCommentRequest{MovieId: 7, CommentId: 1, Text: "second"}
+
+	// чужой текст не меняют ни другие пользователи, ни модераторы
+	for _, ctx := range []context.Context{userContext(3, JWT.RoleViewer), userContext(4, JWT.RoleModerator)} {
+		_, err := uc.EditComment(ctx, req)
+		assert.ErrorIs(t, err, ErrForbidden)
+	}
+	_, err := uc.EditComment(context.Background(), req)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+	assert.Empty(t, repo.updated)
+}
+
+func TestEditCommentMarksEdited(t *testing.T) {
+	repo := newCommentsRepository(&entities.Comment{ID: 1, MovieID: 7, UserID: 2, Text: "first"})
+	uc := newTestUsecase(repo)
+
+	edited, err := uc.EditComment(userContext(2, JWT.RoleViewer), &protos.EditCommentRequest{MovieId: 7, CommentId: 1, Text: "second"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "second", edited.GetText())
+	assert.True(t, edited.GetEdited())
+	assert.EqualValues(t, 1, edited.GetId())
+}
+
+func TestEditCommentValidatesText(t *testing.T) {
+	repo := newCommentsRepository(&entities.Comment{ID: 1, MovieID: 7, UserID: 2, Text: "first"})
+	uc := newTestUsecase(repo)
+
+	_, err := uc.EditComment(userContext(2, JWT.RoleViewer), &protos.EditCommentRequest{MovieId: 7, CommentId: 1, Text: " "})
+
+	assert.Equal(t, []string{"text:required"}, violated(t, err))
+	assert.Empty(t, repo.updated)
+}
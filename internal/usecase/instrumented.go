@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"movieService/internal/errs"
+	"movieService/internal/metrics"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// instrumented — декоратор InterfaceUsecase: для каждого метода открывает спан и снимает
+// длительность и ошибки. Декоратор стоит над usecase, поэтому одинаково учитывает вызовы из HTTP и gRPC.
+type instrumented struct {
+	next    InterfaceUsecase
+	metrics *metrics.Metrics
+	tracer  trace.Tracer
+}
+
+var _ InterfaceUsecase = (*instrumented)(nil)
+
+// Instrument оборачивает usecase метриками и трейсингом.
+func Instrument(next InterfaceUsecase, m *metrics.Metrics) InterfaceUsecase {
+	return &instrumented{next: next, metrics: m, tracer: otel.Tracer("movieService/internal/usecase")}
+}
+
+// observe вызывает метод в спане "usecase.<method>" и учитывает его длительность и ошибку.
+func observe[T any](ctx context.Context, u *instrumented, method string, call func(context.Context) (T, error)) (T, error) {
+	ctx, span := u.tracer.Start(ctx, "usecase."+method)
+	defer span.End()
+
+	start := time.Now()
+	resp, err := call(ctx)
+	u.metrics.ObserveUsecase(method, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, errs.MessageOf(err))
+		span.SetAttributes(attribute.String("error.code", string(errs.CodeOf(err))))
+	}
+	return resp, err
+}
+
+func (u *instrumented) ListMovies(ctx context.Context, req *protos.ListMoviesRequest) (*protos.ListMoviesResponse, error) {
+	return observe(ctx, u, "ListMovies", func(ctx context.Context) (*protos.ListMoviesResponse, error) {
+		return u.next.ListMovies(ctx, req)
+	})
+}
+
+func (u *instrumented) GetMovie(ctx context.Context, req *protos.GetMovieRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "GetMovie", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.GetMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) CreateMovie(ctx context.Context, req *protos.CreateMovieRequest) (*protos.CreateMovieResponse, error) {
+	return observe(ctx, u, "CreateMovie", func(ctx context.Context) (*protos.CreateMovieResponse, error) {
+		return u.next.CreateMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) DeleteMovie(ctx context.Context, req *protos.DeleteMovieRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeleteMovie", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeleteMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) RestoreMovie(ctx context.Context, req *protos.RestoreMovieRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "RestoreMovie", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.RestoreMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) PurgeMovie(ctx context.Context, req *protos.PurgeMovieRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "PurgeMovie", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.PurgeMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) PublishMovie(ctx context.Context, req *protos.PublishMovieRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "PublishMovie", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.PublishMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) ScheduleMovie(ctx context.Context, req *protos.ScheduleMovieRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "ScheduleMovie", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.ScheduleMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) ArchiveMovie(ctx context.Context, req *protos.ArchiveMovieRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "ArchiveMovie", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.ArchiveMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) RevertMovieToDraft(ctx context.Context, req *protos.RevertMovieToDraftRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "RevertMovieToDraft", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.RevertMovieToDraft(ctx, req)
+	})
+}
+
+func (u *instrumented) UpdateMovie(ctx context.Context, req *protos.UpdateMovieRequest) (*protos.UpdateMovieResponse, error) {
+	return observe(ctx, u, "UpdateMovie", func(ctx context.Context) (*protos.UpdateMovieResponse, error) {
+		return u.next.UpdateMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) PatchMovie(ctx context.Context, req *protos.PatchMovieRequest) (*protos.UpdateMovieResponse, error) {
+	return observe(ctx, u, "PatchMovie", func(ctx context.Context) (*protos.UpdateMovieResponse, error) {
+		return u.next.PatchMovie(ctx, req)
+	})
+}
+
+func (u *instrumented) SearchMovies(ctx context.Context, req *protos.SearchMoviesRequest) (*protos.SearchMoviesResponse, error) {
+	return observe(ctx, u, "SearchMovies", func(ctx context.Context) (*protos.SearchMoviesResponse, error) {
+		return u.next.SearchMovies(ctx, req)
+	})
+}
+
+func (u *instrumented) ListMovieTranslations(ctx context.Context, req *protos.ListMovieTranslationsRequest) (*protos.ListMovieTranslationsResponse, error) {
+	return observe(ctx, u, "ListMovieTranslations", func(ctx context.Context) (*protos.ListMovieTranslationsResponse, error) {
+		return u.next.ListMovieTranslations(ctx, req)
+	})
+}
+
+func (u *instrumented) SetMovieTranslation(ctx context.Context, req *protos.SetMovieTranslationRequest) (*protos.MovieTranslation, error) {
+	return observe(ctx, u, "SetMovieTranslation", func(ctx context.Context) (*protos.MovieTranslation, error) {
+		return u.next.SetMovieTranslation(ctx, req)
+	})
+}
+
+func (u *instrumented) DeleteMovieTranslation(ctx context.Context, req *protos.DeleteMovieTranslationRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeleteMovieTranslation", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeleteMovieTranslation(ctx, req)
+	})
+}
+
+func (u *instrumented) SetMovieCertifications(ctx context.Context, req *protos.SetMovieCertificationsRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "SetMovieCertifications", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.SetMovieCertifications(ctx, req)
+	})
+}
+
+func (u *instrumented) ListRatings(ctx context.Context, req *protos.ListRatingsRequest) (*protos.ListRatingsResponse, error) {
+	return observe(ctx, u, "ListRatings", func(ctx context.Context) (*protos.ListRatingsResponse, error) {
+		return u.next.ListRatings(ctx, req)
+	})
+}
+
+func (u *instrumented) GetRating(ctx context.Context, req *protos.GetRatingRequest) (*protos.Rating, error) {
+	return observe(ctx, u, "GetRating", func(ctx context.Context) (*protos.Rating, error) {
+		return u.next.GetRating(ctx, req)
+	})
+}
+
+func (u *instrumented) CreateRating(ctx context.Context, req *protos.CreateRatingRequest) (*protos.CreateRatingResponse, error) {
+	return observe(ctx, u, "CreateRating", func(ctx context.Context) (*protos.CreateRatingResponse, error) {
+		return u.next.CreateRating(ctx, req)
+	})
+}
+
+func (u *instrumented) GetMyRating(ctx context.Context, req *protos.GetMyRatingRequest) (*protos.Rating, error) {
+	return observe(ctx, u, "GetMyRating", func(ctx context.Context) (*protos.Rating, error) {
+		return u.next.GetMyRating(ctx, req)
+	})
+}
+
+func (u *instrumented) DeleteRating(ctx context.Context, req *protos.DeleteRatingRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeleteRating", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeleteRating(ctx, req)
+	})
+}
+
+func (u *instrumented) ListComments(ctx context.Context, req *protos.ListCommentsRequest) (*protos.ListCommentsResponse, error) {
+	return observe(ctx, u, "ListComments", func(ctx context.Context) (*protos.ListCommentsResponse, error) {
+		return u.next.ListComments(ctx, req)
+	})
+}
+
+func (u *instrumented) GetComment(ctx context.Context, req *protos.GetCommentRequest) (*protos.Comment, error) {
+	return observe(ctx, u, "GetComment", func(ctx context.Context) (*protos.Comment, error) {
+		return u.next.GetComment(ctx, req)
+	})
+}
+
+func (u *instrumented) CreateComment(ctx context.Context, req *protos.CreateCommentRequest) (*protos.CreateCommentResponse, error) {
+	return observe(ctx, u, "CreateComment", func(ctx context.Context) (*protos.CreateCommentResponse, error) {
+		return u.next.CreateComment(ctx, req)
+	})
+}
+
+func (u *instrumented) EditComment(ctx context.Context, req *protos.EditCommentRequest) (*protos.Comment, error) {
+	return observe(ctx, u, "EditComment", func(ctx context.Context) (*protos.Comment, error) {
+		return u.next.EditComment(ctx, req)
+	})
+}
+
+func (u *instrumented) DeleteComment(ctx context.Context, req *protos.DeleteCommentRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeleteComment", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeleteComment(ctx, req)
+	})
+}
+
+func (u *instrumented) ListGenres(ctx context.Context, req *protos.ListGenresRequest) (*protos.ListGenresResponse, error) {
+	return observe(ctx, u, "ListGenres", func(ctx context.Context) (*protos.ListGenresResponse, error) {
+		return u.next.ListGenres(ctx, req)
+	})
+}
+
+func (u *instrumented) CreateGenre(ctx context.Context, req *protos.CreateGenreRequest) (*protos.CreateGenreResponse, error) {
+	return observe(ctx, u, "CreateGenre", func(ctx context.Context) (*protos.CreateGenreResponse, error) {
+		return u.next.CreateGenre(ctx, req)
+	})
+}
+
+func (u *instrumented) UpdateGenre(ctx context.Context, req *protos.UpdateGenreRequest) (*protos.UpdateGenreResponse, error) {
+	return observe(ctx, u, "UpdateGenre", func(ctx context.Context) (*protos.UpdateGenreResponse, error) {
+		return u.next.UpdateGenre(ctx, req)
+	})
+}
+
+func (u *instrumented) DeleteGenre(ctx context.Context, req *protos.DeleteGenreRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeleteGenre", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeleteGenre(ctx, req)
+	})
+}
+
+func (u *instrumented) ListPeople(ctx context.Context, req *protos.ListPeopleRequest) (*protos.ListPeopleResponse, error) {
+	return observe(ctx, u, "ListPeople", func(ctx context.Context) (*protos.ListPeopleResponse, error) {
+		return u.next.ListPeople(ctx, req)
+	})
+}
+
+func (u *instrumented) GetPerson(ctx context.Context, req *protos.GetPersonRequest) (*protos.Person, error) {
+	return observe(ctx, u, "GetPerson", func(ctx context.Context) (*protos.Person, error) {
+		return u.next.GetPerson(ctx, req)
+	})
+}
+
+func (u *instrumented) CreatePerson(ctx context.Context, req *protos.CreatePersonRequest) (*protos.CreatePersonResponse, error) {
+	return observe(ctx, u, "CreatePerson", func(ctx context.Context) (*protos.CreatePersonResponse, error) {
+		return u.next.CreatePerson(ctx, req)
+	})
+}
+
+func (u *instrumented) UpdatePerson(ctx context.Context, req *protos.UpdatePersonRequest) (*protos.UpdatePersonResponse, error) {
+	return observe(ctx, u, "UpdatePerson", func(ctx context.Context) (*protos.UpdatePersonResponse, error) {
+		return u.next.UpdatePerson(ctx, req)
+	})
+}
+
+func (u *instrumented) DeletePerson(ctx context.Context, req *protos.DeletePersonRequest) (*emptypb.Empty, error) {
+	return observe(ctx, u, "DeletePerson", func(ctx context.Context) (*emptypb.Empty, error) {
+		return u.next.DeletePerson(ctx, req)
+	})
+}
+
+func (u *instrumented) ListFilmography(ctx context.Context, req *protos.ListFilmographyRequest) (*protos.ListFilmographyResponse, error) {
+	return observe(ctx, u, "ListFilmography", func(ctx context.Context) (*protos.ListFilmographyResponse, error) {
+		return u.next.ListFilmography(ctx, req)
+	})
+}
+
+func (u *instrumented) SetMovieCredits(ctx context.Context, req *protos.SetMovieCreditsRequest) (*protos.Movie, error) {
+	return observe(ctx, u, "SetMovieCredits", func(ctx context.Context) (*protos.Movie, error) {
+		return u.next.SetMovieCredits(ctx, req)
+	})
+}
+
+func (u *instrumented) ListSeries(ctx context.Context, req *protos.ListSeriesRequest) (*protos.ListSeriesResponse, error) {
+	return observe(ctx, u, "ListSeries", func(ctx context.Context) (*protos.ListSeriesResponse, error) {
+		return u.next.ListSeries(ctx, req)
+	})
+}
+
+func (u *instrumented) GetSeries(ctx context.Context, req *protos.GetSeriesRequest) (*protos.Series, error) {
+	return observe(ctx, u, "GetSeries", func(ctx context.Context) (*protos.Series, error) {
+		return u.next.GetSeries(ctx, req)
+	})
+}
+
+func (u *instrumented) ListEpisodes(ctx context.Context, req *protos.ListEpisodesRequest) (*protos.ListEpisodesResponse, error) {
+	return observe(ctx, u, "ListEpisodes", func(ctx context.Context) (*protos.ListEpisodesResponse, error) {
+		return u.next.ListEpisodes(ctx, req)
+	})
+}
+
+func (u *instrumented) GetEpisode(ctx context.Context, req *protos.GetEpisodeRequest) (*protos.Episode, error) {
+	return observe(ctx, u, "GetEpisode", func(ctx context.Context) (*protos.Episode, error) {
+		return u.next.GetEpisode(ctx, req)
+	})
+}
+
+func (u *instrumented) GetNextEpisode(ctx context.Context, req *protos.GetNextEpisodeRequest) (*protos.GetNextEpisodeResponse, error) {
+	return observe(ctx, u, "GetNextEpisode", func(ctx context.Context) (*protos.GetNextEpisodeResponse, error) {
+		return u.next.GetNextEpisode(ctx, req)
+	})
+}
@@ -16,21 +16,29 @@ type InterfaceUsecase interface {
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения, поддерживает отмену и дедлайны.
-	//   - req: DTO с параметрами пагинации и фильтрации по жанрам.
+	//   - req: DTO с параметрами пагинации, фильтрации по жанрам и участнику (person_id), сортировки (sort_by, sort_order)
+	//     и предпочитаемыми языками (locale). Фильтр status доступен только администраторам.
+	//     Фильтр по возрастному рейтингу (max_age, certifications, country) для детского профиля
+	//     дополняется ограничением из токена, которое запрос ослабить не может.
 	//
 	// Возвращает:
-	//   - ListMoviesResponse: DTO со списком фильмов и общим количеством.
-	//   - error: ошибку выполнения, если что-то пошло не так.
+	//   - ListMoviesResponse: DTO со списком фильмов, переведённых на лучший доступный язык, и общим количеством.
+	//     Администраторы видят фильмы во всех состояниях, остальные — только опубликованные.
+	//   - error: ErrInvalidSort для неизвестной сортировки, ошибку для недопустимого фильтра status
+	//     или ошибку выполнения, если что-то пошло не так.
 	ListMovies(ctx context.Context, req *protos.ListMoviesRequest) (*protos.ListMoviesResponse, error)
 
-	// GetMovie возвращает подробную информацию о фильме по его ID.
+	// GetMovie возвращает подробную информацию о фильме по его ID, включая титры.
+	// Название и описание переводятся на самый предпочтительный из языков locale, для которого есть перевод.
+	// Неопубликованный фильм для всех, кроме администраторов, не отличается от несуществующего;
+	// так же скрыт от детского профиля фильм, не подходящий под возрастное ограничение из токена.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с идентификатором фильма.
+	//   - req: DTO с идентификатором фильма и предпочитаемыми языками.
 	//
 	// Возвращает:
-	//   - Movie: DTO с деталями фильма.
+	//   - Movie: DTO с деталями фильма и локалью текста.
 	//   - error: ошибку, если фильм не найден или произошёл сбой БД.
 	GetMovie(ctx context.Context, req *protos.GetMovieRequest) (*protos.Movie, error)
 
@@ -42,10 +50,10 @@ type InterfaceUsecase interface {
 	//
 	// Возвращает:
 	//   - CreateMovieResponse: DTO с созданным фильмом.
-	//   - error: ошибку валидации или сбой при записи в БД.
+	//   - error: ошибку валидации с нарушениями по полям или сбой при записи в БД.
 	CreateMovie(ctx context.Context, req *protos.CreateMovieRequest) (*protos.CreateMovieResponse, error)
 
-	// DeleteMovie удаляет фильм по его ID.
+	// DeleteMovie мягко удаляет фильм по его ID: фильм скрывается из выдачи до восстановления или очистки.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
@@ -56,95 +64,449 @@ type InterfaceUsecase interface {
 	//   - error: ошибку, если фильм не найден или сбой БД.
 	DeleteMovie(ctx context.Context, req *protos.DeleteMovieRequest) (*emptypb.Empty, error)
 
+	// RestoreMovie восстанавливает мягко удалённый фильм.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором фильма.
+	//
+	// Возвращает:
+	//   - Movie: DTO с восстановленным фильмом.
+	//   - error: ошибку, если удалённый фильм с таким ID не найден, или сбой БД.
+	RestoreMovie(ctx context.Context, req *protos.RestoreMovieRequest) (*protos.Movie, error)
+
+	// PurgeMovie окончательно удаляет мягко удалённый фильм вместе с жанрами, оценками и комментариями.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором фильма.
+	//
+	// Возвращает:
+	//   - Empty: пустой ответ при успешной очистке.
+	//   - error: ошибку, если фильм не найден или ещё не удалён, или сбой БД.
+	PurgeMovie(ctx context.Context, req *protos.PurgeMovieRequest) (*emptypb.Empty, error)
+
+	// PublishMovie публикует фильм сразу, из черновика, расписания или архива.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма и необязательным временем снятия с публикации.
+	//
+	// Возвращает:
+	//   - Movie: DTO с опубликованным фильмом.
+	//   - error: ошибку валидации unpublish_at, ошибку, если фильм не найден или уже опубликован, или сбой БД.
+	PublishMovie(ctx context.Context, req *protos.PublishMovieRequest) (*protos.Movie, error)
+
+	// ScheduleMovie планирует публикацию фильма на publish_at; в это время фильм становится виден всем.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма, временем публикации и необязательным временем снятия с публикации.
+	//
+	// Возвращает:
+	//   - Movie: DTO с запланированным фильмом.
+	//   - error: ошибку валидации времени, ошибку, если фильм не найден или уже опубликован, или сбой БД.
+	ScheduleMovie(ctx context.Context, req *protos.ScheduleMovieRequest) (*protos.Movie, error)
+
+	// ArchiveMovie снимает фильм с публикации или отменяет запланированную публикацию.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма.
+	//
+	// Возвращает:
+	//   - Movie: DTO с фильмом в архиве.
+	//   - error: ошибку, если фильм не найден или уже в архиве, или сбой БД.
+	ArchiveMovie(ctx context.Context, req *protos.ArchiveMovieRequest) (*protos.Movie, error)
+
+	// RevertMovieToDraft возвращает фильм в черновики и сбрасывает расписание публикации.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма.
+	//
+	// Возвращает:
+	//   - Movie: DTO с фильмом-черновиком.
+	//   - error: ошибку, если фильм не найден или уже черновик, или сбой БД.
+	RevertMovieToDraft(ctx context.Context, req *protos.RevertMovieToDraftRequest) (*protos.Movie, error)
+
+	// UpdateMovie полностью заменяет данные фильма и список его жанров.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма и новыми значениями всех полей.
+	//
+	// Возвращает:
+	//   - UpdateMovieResponse: DTO с обновлённым фильмом.
+	//   - error: ошибку, если фильм не найден или сбой БД.
+	UpdateMovie(ctx context.Context, req *protos.UpdateMovieRequest) (*protos.UpdateMovieResponse, error)
+
+	// PatchMovie частично обновляет фильм: меняются только поля из update_mask.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма, новыми значениями и маской обновляемых полей.
+	//
+	// Возвращает:
+	//   - UpdateMovieResponse: DTO с обновлённым фильмом.
+	//   - error: ошибку при пустой или неизвестной маске, если фильм не найден или сбой БД.
+	PatchMovie(ctx context.Context, req *protos.PatchMovieRequest) (*protos.UpdateMovieResponse, error)
+
+	// SearchMovies ищет фильмы по словам из названия и описания с сортировкой по релевантности.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с поисковой строкой (слова ищутся по префиксу), пагинацией, фильтрами по жанрам
+	//     и возрастному рейтингу, как в ListMovies.
+	//
+	// Возвращает:
+	//   - SearchMoviesResponse: DTO с найденными фильмами и их общим количеством.
+	//   - error: ErrEmptySearchQuery для пустой строки или ошибку выполнения запроса.
+	SearchMovies(ctx context.Context, req *protos.SearchMoviesRequest) (*protos.SearchMoviesResponse, error)
+
+	// ListMovieTranslations возвращает все переводы названия и описания фильма.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма.
+	//
+	// Возвращает:
+	//   - ListMovieTranslationsResponse: DTO с переводами по возрастанию локали.
+	//   - error: ошибку, если фильм не найден или сбой БД.
+	ListMovieTranslations(ctx context.Context, req *protos.ListMovieTranslationsRequest) (*protos.ListMovieTranslationsResponse, error)
+
+	// SetMovieTranslation создаёт или заменяет перевод названия и описания фильма на указанную локаль.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма, локалью, названием и описанием.
+	//
+	// Возвращает:
+	//   - MovieTranslation: DTO с сохранённым переводом.
+	//   - error: ошибку валидации (в том числе для локали по умолчанию), ошибку, если фильм не найден, или сбой БД.
+	SetMovieTranslation(ctx context.Context, req *protos.SetMovieTranslationRequest) (*protos.MovieTranslation, error)
+
+	// DeleteMovieTranslation удаляет перевод фильма на указанную локаль.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма и локалью.
+	//
+	// Возвращает:
+	//   - Empty: пустой ответ при успешном удалении.
+	//   - error: ошибку валидации локали, ошибку, если перевода нет, или сбой БД.
+	DeleteMovieTranslation(ctx context.Context, req *protos.DeleteMovieTranslationRequest) (*emptypb.Empty, error)
+
+	// SetMovieCertifications полностью заменяет возрастные рейтинги фильма по странам и описания его содержимого.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма, рейтингами (страна, обозначение, минимальный возраст) и описаниями содержимого.
+	//
+	// Возвращает:
+	//   - Movie: DTO с фильмом, новыми рейтингами и описаниями.
+	//   - error: ошибку валидации, ошибку, если фильм не найден, или сбой БД.
+	SetMovieCertifications(ctx context.Context, req *protos.SetMovieCertificationsRequest) (*protos.Movie, error)
+
 	// --- Rating ---
 
-	// ListRatings возвращает постраничный список оценок для указанного фильма.
+	// ListRatings возвращает постраничный список оценок фильма, сериала или эпизода.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с ID фильма и параметрами пагинации.
+	//   - req: DTO с ID фильма (сериала или эпизода) и параметрами пагинации.
 	//
 	// Возвращает:
 	//   - ListRatingsResponse: DTO со списком оценок и общим количеством.
 	//   - error: ошибку выполнения.
 	ListRatings(ctx context.Context, req *protos.ListRatingsRequest) (*protos.ListRatingsResponse, error)
 
-	// GetRating возвращает конкретную оценку по ID фильма и ID оценки.
+	// GetRating возвращает конкретную оценку по ID фильма (сериала или эпизода) и ID оценки.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с идентификаторами фильма и оценки.
+	//   - req: DTO с идентификаторами объекта и оценки.
 	//
 	// Возвращает:
 	//   - Rating: DTO с деталями оценки.
 	//   - error: ошибку, если оценка не найдена или сбой БД.
 	GetRating(ctx context.Context, req *protos.GetRatingRequest) (*protos.Rating, error)
 
-	// CreateRating создаёт новую оценку для фильма.
+	// CreateRating создаёт оценку фильма, сериала или эпизода или обновляет score, если пользователь
+	// уже оценивал этот объект.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с данными для новой оценки (ID фильма, ID пользователя, значение от 1 до 10).
+	//   - req: DTO с данными для новой оценки (ID объекта, значение от 1 до 10); автор берётся из контекста.
 	//
 	// Возвращает:
-	//   - CreateRatingResponse: DTO с созданной оценкой.
-	//   - error: ошибку валидации или записи в БД.
+	//   - CreateRatingResponse: DTO с сохранённой оценкой и признаком created.
+	//   - error: ошибку аутентификации, валидации или записи в БД.
 	CreateRating(ctx context.Context, req *protos.CreateRatingRequest) (*protos.CreateRatingResponse, error)
 
-	// DeleteRating удаляет оценку по ID фильма и ID оценки.
+	// GetMyRating возвращает оценку фильма, сериала или эпизода, поставленную текущим пользователем.
 	//
 	// Параметры:
-	//   - ctx: контекст выполнения.
-	//   - req: DTO с идентификаторами фильма и оценки.
+	//   - ctx: контекст выполнения с пользователем из токена.
+	//   - req: DTO с ID фильма, сериала или эпизода.
+	//
+	// Возвращает:
+	//   - Rating: DTO с оценкой пользователя.
+	//   - error: ошибку аутентификации, если пользователь не оценивал объект, или сбой БД.
+	GetMyRating(ctx context.Context, req *protos.GetMyRatingRequest) (*protos.Rating, error)
+
+	// DeleteRating удаляет оценку по ID фильма (сериала или эпизода) и ID оценки. Удалить оценку может только её автор.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения с пользователем из токена.
+	//   - req: DTO с идентификаторами объекта и оценки.
 	//
 	// Возвращает:
 	//   - Empty: пустой ответ при успешном удалении.
-	//   - error: ошибку, если оценка не найдена или сбой БД.
+	//   - error: ошибку, если оценка не найдена, принадлежит другому пользователю или сбой БД.
 	DeleteRating(ctx context.Context, req *protos.DeleteRatingRequest) (*emptypb.Empty, error)
 
 	// --- Comment ---
 
-	// ListComments возвращает постраничный список комментариев к фильму.
+	// ListComments возвращает постраничный список комментариев к фильму, сериалу или эпизоду: корневые
+	// комментарии или прямые ответы на parent_id, при with_replies — вместе с ветками ответов.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с ID фильма и параметрами пагинации.
+	//   - req: DTO с ID объекта, уровнем ветки и параметрами пагинации.
 	//
 	// Возвращает:
 	//   - ListCommentsResponse: DTO со списком комментариев и общим количеством.
-	//   - error: ошибку выполнения.
+	//   - error: ошибку, если родительский комментарий не найден, или ошибку выполнения.
 	ListComments(ctx context.Context, req *protos.ListCommentsRequest) (*protos.ListCommentsResponse, error)
 
-	// GetComment возвращает конкретный комментарий по ID фильма и ID комментария.
+	// GetComment возвращает конкретный комментарий по ID фильма (сериала или эпизода) и ID комментария.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с идентификаторами фильма и комментария.
+	//   - req: DTO с идентификаторами объекта и комментария.
 	//
 	// Возвращает:
 	//   - Comment: DTO с текстом и метаданными комментария.
 	//   - error: ошибку, если комментарий не найден или сбой БД.
 	GetComment(ctx context.Context, req *protos.GetCommentRequest) (*protos.Comment, error)
 
-	// CreateComment создаёт новый комментарий к фильму.
+	// CreateComment создаёт новый комментарий к фильму, сериалу или эпизоду или ответ на комментарий parent_id.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения.
-	//   - req: DTO с данными для нового комментария (ID фильма, ID пользователя, текст).
+	//   - req: DTO с данными для нового комментария (ID объекта, текст, родитель); автор берётся из контекста.
 	//
 	// Возвращает:
 	//   - CreateCommentResponse: DTO с созданным комментарием.
-	//   - error: ошибку валидации или записи в БД.
+	//   - error: ошибку аутентификации, валидации (в т.ч. превышение глубины ветки) или записи в БД.
 	CreateComment(ctx context.Context, req *protos.CreateCommentRequest) (*protos.CreateCommentResponse, error)
 
-	// DeleteComment удаляет комментарий по ID фильма и ID комментария.
+	// EditComment заменяет текст комментария и помечает его как отредактированный.
+	// Редактировать комментарий может только его автор.
 	//
 	// Параметры:
-	//   - ctx: контекст выполнения.
-	//   - req: DTO с идентификаторами фильма и комментария.
+	//   - ctx: контекст выполнения с пользователем из токена.
+	//   - req: DTO с идентификаторами объекта и комментария и новым текстом.
+	//
+	// Возвращает:
+	//   - Comment: DTO с обновлённым комментарием.
+	//   - error: ошибку, если комментарий не найден, принадлежит другому пользователю, текст невалиден или сбой БД.
+	EditComment(ctx context.Context, req *protos.EditCommentRequest) (*protos.Comment, error)
+
+	// DeleteComment удаляет комментарий по ID фильма (сериала или эпизода) и ID комментария вместе со всеми ответами на него.
+	// Удалить комментарий может его автор, а чужой — модератор или администратор.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения с пользователем из токена.
+	//   - req: DTO с идентификаторами объекта и комментария.
 	//
 	// Возвращает:
 	//   - Empty: пустой ответ при успешном удалении.
-	//   - error: ошибку, если комментарий не найден или сбой БД.
+	//   - error: ошибку, если комментарий не найден, принадлежит другому пользователю или сбой БД.
 	DeleteComment(ctx context.Context, req *protos.DeleteCommentRequest) (*emptypb.Empty, error)
+
+	// --- Genre ---
+
+	// ListGenres возвращает все жанры с количеством фильмов в каждом.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: пустой DTO запроса.
+	//
+	// Возвращает:
+	//   - ListGenresResponse: DTO со списком жанров и их количеством.
+	//   - error: ошибку выполнения.
+	ListGenres(ctx context.Context, req *protos.ListGenresRequest) (*protos.ListGenresResponse, error)
+
+	// CreateGenre создаёт новый жанр.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с названием жанра.
+	//
+	// Возвращает:
+	//   - CreateGenreResponse: DTO с созданным жанром.
+	//   - error: ошибку валидации названия, ошибку, если жанр с таким названием уже есть, или сбой БД.
+	CreateGenre(ctx context.Context, req *protos.CreateGenreRequest) (*protos.CreateGenreResponse, error)
+
+	// UpdateGenre переименовывает жанр.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID жанра и новым названием.
+	//
+	// Возвращает:
+	//   - UpdateGenreResponse: DTO с обновлённым жанром.
+	//   - error: ошибку, если жанр не найден, название занято или сбой БД.
+	UpdateGenre(ctx context.Context, req *protos.UpdateGenreRequest) (*protos.UpdateGenreResponse, error)
+
+	// DeleteGenre удаляет жанр.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID жанра и флагом force для удаления вместе с привязками к фильмам.
+	//
+	// Возвращает:
+	//   - Empty: пустой ответ при успешном удалении.
+	//   - error: ошибку, если жанр не найден, используется фильмами (без force) или сбой БД.
+	DeleteGenre(ctx context.Context, req *protos.DeleteGenreRequest) (*emptypb.Empty, error)
+
+	// --- People ---
+
+	// ListPeople возвращает постраничный список персон, отсортированный по имени.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с подстрокой имени и параметрами пагинации.
+	//
+	// Возвращает:
+	//   - ListPeopleResponse: DTO со списком персон и общим количеством.
+	//   - error: ошибку выполнения.
+	ListPeople(ctx context.Context, req *protos.ListPeopleRequest) (*protos.ListPeopleResponse, error)
+
+	// GetPerson возвращает персону по её ID.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором персоны.
+	//
+	// Возвращает:
+	//   - Person: DTO с данными персоны.
+	//   - error: ошибку, если персона не найдена или сбой БД.
+	GetPerson(ctx context.Context, req *protos.GetPersonRequest) (*protos.Person, error)
+
+	// CreatePerson создаёт новую персону.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с именем, биографией, датой рождения и фото.
+	//
+	// Возвращает:
+	//   - CreatePersonResponse: DTO с созданной персоной.
+	//   - error: ошибку валидации с нарушениями по полям или сбой при записи в БД.
+	CreatePerson(ctx context.Context, req *protos.CreatePersonRequest) (*protos.CreatePersonResponse, error)
+
+	// UpdatePerson полностью заменяет данные персоны.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID персоны и новыми значениями всех полей.
+	//
+	// Возвращает:
+	//   - UpdatePersonResponse: DTO с обновлённой персоной.
+	//   - error: ошибку валидации, ошибку, если персона не найдена, или сбой БД.
+	UpdatePerson(ctx context.Context, req *protos.UpdatePersonRequest) (*protos.UpdatePersonResponse, error)
+
+	// DeletePerson удаляет персону.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID персоны и флагом force для удаления вместе с участием в фильмах.
+	//
+	// Возвращает:
+	//   - Empty: пустой ответ при успешном удалении.
+	//   - error: ошибку, если персона не найдена, указана в титрах фильмов (без force) или сбой БД.
+	DeletePerson(ctx context.Context, req *protos.DeletePersonRequest) (*emptypb.Empty, error)
+
+	// ListFilmography возвращает персону и фильмы, в которых она участвовала, от новых к старым.
+	// Детскому профилю возвращаются только фильмы, подходящие под возрастное ограничение из токена.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID персоны.
+	//
+	// Возвращает:
+	//   - ListFilmographyResponse: DTO с персоной и её участием в фильмах.
+	//   - error: ошибку, если персона не найдена или сбой БД.
+	ListFilmography(ctx context.Context, req *protos.ListFilmographyRequest) (*protos.ListFilmographyResponse, error)
+
+	// SetMovieCredits полностью заменяет титры фильма.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID фильма и списком участников (персона, роль, персонаж, порядок в титрах).
+	//
+	// Возвращает:
+	//   - Movie: DTO с фильмом и новыми титрами.
+	//   - error: ошибку валидации, ошибку, если фильм или персона не найдены, или сбой БД.
+	SetMovieCredits(ctx context.Context, req *protos.SetMovieCreditsRequest) (*protos.Movie, error)
+
+	// --- Series ---
+
+	// ListSeries возвращает постраничный список сериалов, отсортированный по названию.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с параметрами пагинации.
+	//
+	// Возвращает:
+	//   - ListSeriesResponse: DTO со списком сериалов и общим количеством.
+	//   - error: ошибку выполнения.
+	ListSeries(ctx context.Context, req *protos.ListSeriesRequest) (*protos.ListSeriesResponse, error)
+
+	// GetSeries возвращает сериал по его ID вместе со списком сезонов.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором сериала.
+	//
+	// Возвращает:
+	//   - Series: DTO с сериалом, сезонами и агрегатами оценок.
+	//   - error: ошибку, если сериал не найден или сбой БД.
+	GetSeries(ctx context.Context, req *protos.GetSeriesRequest) (*protos.Series, error)
+
+	// ListEpisodes возвращает эпизоды сериала по порядку сезонов и номеров, всех сезонов или одного.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с ID сериала и номером сезона (0 — все сезоны).
+	//
+	// Возвращает:
+	//   - ListEpisodesResponse: DTO со списком эпизодов.
+	//   - error: ошибку, если сериал не найден или сбой БД.
+	ListEpisodes(ctx context.Context, req *protos.ListEpisodesRequest) (*protos.ListEpisodesResponse, error)
+
+	// GetEpisode возвращает эпизод по его ID.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором эпизода.
+	//
+	// Возвращает:
+	//   - Episode: DTO с эпизодом, номерами сезона и эпизода и агрегатами оценок.
+	//   - error: ошибку, если эпизод не найден или сбой БД.
+	GetEpisode(ctx context.Context, req *protos.GetEpisodeRequest) (*protos.Episode, error)
+
+	// GetNextEpisode возвращает эпизод, который идёт после указанного: следующий в сезоне или первый
+	// эпизод следующего сезона.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения.
+	//   - req: DTO с идентификатором текущего эпизода.
+	//
+	// Возвращает:
+	//   - GetNextEpisodeResponse: DTO со следующим эпизодом; episode не задан, если текущий — последний.
+	//   - error: ошибку, если текущий эпизод не найден, или сбой БД.
+	GetNextEpisode(ctx context.Context, req *protos.GetNextEpisodeRequest) (*protos.GetNextEpisodeResponse, error)
 }
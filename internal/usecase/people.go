@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"movieService/internal/entities"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListPeople возвращает постраничный список персон, отсортированный по имени.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с подстрокой имени и параметрами пагинации.
+//
+// Возвращает:
+//   - ListPeopleResponse: DTO со списком персон и общим количеством.
+//   - error: ошибку выполнения.
+func (uc *Usecase) ListPeople(ctx context.Context, req *protos.ListPeopleRequest) (*protos.ListPeopleResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListPeople: входной запрос",
+		zap.String("query", req.GetQuery()),
+		zap.Int32("page", req.GetPage()),
+		zap.Int32("per_page", req.GetPerPage()),
+	)
+
+	listRes, err := uc.repo.ListPeople(ctx, &entities.ListPeopleRequest{
+		Query:   req.GetQuery(),
+		Page:    int(req.GetPage()),
+		PerPage: int(req.GetPerPage()),
+	})
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListPeople: ошибка получения персон", zap.Error(err))
+		return nil, err
+	}
+
+	peopleProto := make([]*protos.Person, 0, len(listRes.People))
+	for _, p := range listRes.People {
+		peopleProto = append(peopleProto, personToProto(p))
+	}
+
+	uc.logger(ctx).Info("Usecase.ListPeople: сформирован ответ",
+		zap.Int("returned", len(peopleProto)),
+		zap.Int("total", listRes.Total),
+	)
+	return &protos.ListPeopleResponse{People: peopleProto, Total: int32(listRes.Total)}, nil
+}
+
+// GetPerson возвращает персону по её ID.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с идентификатором персоны.
+//
+// Возвращает:
+//   - Person: DTO с данными персоны.
+//   - error: ошибку, если персона не найдена или сбой БД.
+func (uc *Usecase) GetPerson(ctx context.Context, req *protos.GetPersonRequest) (*protos.Person, error) {
+	uc.logger(ctx).Info("Usecase.GetPerson: входной запрос", zap.Int32("id", req.GetId()))
+
+	person, err := uc.repo.GetPerson(ctx, int(req.GetId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.GetPerson: ошибка получения персоны", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+	return personToProto(person), nil
+}
+
+// CreatePerson создаёт новую персону.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с именем, биографией, датой рождения и фото.
+//
+// Возвращает:
+//   - CreatePersonResponse: DTO с созданной персоной.
+//   - error: ошибку валидации с нарушениями по полям или сбой при записи в БД.
+func (uc *Usecase) CreatePerson(ctx context.Context, req *protos.CreatePersonRequest) (*protos.CreatePersonResponse, error) {
+	uc.logger(ctx).Info("Usecase.CreatePerson: входной запрос", zap.String("name", req.GetName()))
+
+	personEntity := &entities.Person{
+		Name:     req.GetName(),
+		Bio:      req.GetBio(),
+		PhotoURL: req.GetPhotoUrl(),
+	}
+	if req.GetBirthDate() != nil {
+		personEntity.BirthDate = req.GetBirthDate().AsTime()
+	}
+	if err := uc.validatePerson(personEntity); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.CreatePerson(ctx, personEntity)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.CreatePerson: ошибка создания персоны", zap.Error(err))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.CreatePerson: персона успешно создана", zap.Int("id", created.ID))
+	return &protos.CreatePersonResponse{Person: personToProto(created)}, nil
+}
+
+// UpdatePerson полностью заменяет данные персоны.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID персоны и новыми значениями всех полей.
+//
+// Возвращает:
+//   - UpdatePersonResponse: DTO с обновлённой персоной.
+//   - error: ошибку валидации, ошибку, если персона не найдена, или сбой БД.
+func (uc *Usecase) UpdatePerson(ctx context.Context, req *protos.UpdatePersonRequest) (*protos.UpdatePersonResponse, error) {
+	uc.logger(ctx).Info("Usecase.UpdatePerson: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.String("name", req.GetName()),
+	)
+
+	personEntity := &entities.Person{
+		ID:       int(req.GetId()),
+		Name:     req.GetName(),
+		Bio:      req.GetBio(),
+		PhotoURL: req.GetPhotoUrl(),
+	}
+	if req.GetBirthDate() != nil {
+		personEntity.BirthDate = req.GetBirthDate().AsTime()
+	}
+	if err := uc.validatePerson(personEntity); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.UpdatePerson(ctx, personEntity)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.UpdatePerson: ошибка обновления персоны", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.UpdatePerson: персона успешно обновлена", zap.Int("id", updated.ID))
+	return &protos.UpdatePersonResponse{Person: personToProto(updated)}, nil
+}
+
+// DeletePerson удаляет персону.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID персоны и флагом force для удаления вместе с участием в фильмах.
+//
+// Возвращает:
+//   - Empty: пустой ответ при успешном удалении.
+//   - error: ошибку, если персона не найдена, указана в титрах фильмов (без force) или сбой БД.
+func (uc *Usecase) DeletePerson(ctx context.Context, req *protos.DeletePersonRequest) (*emptypb.Empty, error) {
+	uc.logger(ctx).Info("Usecase.DeletePerson: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.Bool("force", req.GetForce()),
+	)
+
+	personEntity := &entities.Person{ID: int(req.GetId())}
+	if err := uc.repo.DeletePerson(ctx, personEntity, req.GetForce()); err != nil {
+		uc.logger(ctx).Error("Usecase.DeletePerson: ошибка удаления персоны", zap.Error(err), zap.Int("id", personEntity.ID))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.DeletePerson: персона успешно удалена", zap.Int("id", personEntity.ID))
+	return &emptypb.Empty{}, nil
+}
+
+// ListFilmography возвращает персону и фильмы, в которых она участвовала, от новых к старым.
+// Детскому профилю возвращаются только фильмы, подходящие под возрастное ограничение из токена.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID персоны.
+//
+// Возвращает:
+//   - ListFilmographyResponse: DTO с персоной и её участием в фильмах.
+//   - error: ошибку, если персона не найдена или сбой БД.
+func (uc *Usecase) ListFilmography(ctx context.Context, req *protos.ListFilmographyRequest) (*protos.ListFilmographyResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListFilmography: входной запрос", zap.Int32("person_id", req.GetPersonId()))
+
+	// 1. Несуществующая персона — 404, а не пустая фильмография
+	person, err := uc.repo.GetPerson(ctx, int(req.GetPersonId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListFilmography: ошибка получения персоны", zap.Error(err), zap.Int32("person_id", req.GetPersonId()))
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий; детскому профилю видны только фильмы, подходящие под ограничение из токена
+	age, err := uc.ageFilter(ctx, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := uc.repo.ListFilmography(ctx, person.ID, age)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListFilmography: ошибка получения фильмографии", zap.Error(err), zap.Int("person_id", person.ID))
+		return nil, err
+	}
+
+	// 3. Маппим Entity → Protobuf
+	entriesProto := make([]*protos.FilmographyEntry, 0, len(entries))
+	for _, e := range entries {
+		entriesProto = append(entriesProto, &protos.FilmographyEntry{
+			MovieId:      int32(e.MovieID),
+			Title:        e.Title,
+			CoverUrl:     e.CoverURL,
+			ReleaseDate:  timestamppb.New(e.ReleaseDate),
+			Role:         e.Role,
+			Character:    e.Character,
+			BillingOrder: int32(e.BillingOrder),
+		})
+	}
+
+	uc.logger(ctx).Info("Usecase.ListFilmography: сформирован ответ", zap.Int("returned", len(entriesProto)))
+	return &protos.ListFilmographyResponse{Person: personToProto(person), Entries: entriesProto}, nil
+}
+
+// SetMovieCredits полностью заменяет титры фильма.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма и списком участников (персона, роль, персонаж, порядок в титрах).
+//
+// Возвращает:
+//   - Movie: DTO с фильмом и новыми титрами.
+//   - error: ошибку валидации, ошибку, если фильм или персона не найдены, или сбой БД.
+func (uc *Usecase) SetMovieCredits(ctx context.Context, req *protos.SetMovieCreditsRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.SetMovieCredits: входной запрос",
+		zap.Int32("movie_id", req.GetMovieId()),
+		zap.Int("credits", len(req.GetCredits())),
+	)
+
+	// 1. Маппим Protobuf → Entity
+	credits := make([]entities.Credit, 0, len(req.GetCredits()))
+	for _, c := range req.GetCredits() {
+		credits = append(credits, entities.Credit{
+			MovieID:      int(req.GetMovieId()),
+			PersonID:     int(c.GetPersonId()),
+			Role:         c.GetRole(),
+			Character:    c.GetCharacter(),
+			BillingOrder: int(c.GetBillingOrder()),
+		})
+	}
+	if err := uc.validateCredits(credits); err != nil {
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий
+	updated, err := uc.repo.SetMovieCredits(ctx, int(req.GetMovieId()), credits)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.SetMovieCredits: ошибка сохранения титров", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.SetMovieCredits: титры успешно сохранены",
+		zap.Int("movie_id", updated.ID),
+		zap.Int("credits", len(updated.Credits)),
+	)
+	return movieToProto(updated), nil
+}
+
+// personToProto маппит сущность персоны в Protobuf; неизвестная дата рождения не передаётся.
+func personToProto(p *entities.Person) *protos.Person {
+	person := &protos.Person{
+		Id:        int32(p.ID),
+		Name:      p.Name,
+		Bio:       p.Bio,
+		PhotoUrl:  p.PhotoURL,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+	if !p.BirthDate.IsZero() {
+		person.BirthDate = timestamppb.New(p.BirthDate)
+	}
+	return person
+}
+
+// creditToProto маппит участие персоны в фильме в Protobuf.
+func creditToProto(c entities.Credit) *protos.Credit {
+	return &protos.Credit{
+		PersonId:     int32(c.PersonID),
+		PersonName:   c.PersonName,
+		Role:         c.Role,
+		Character:    c.Character,
+		BillingOrder: int32(c.BillingOrder),
+	}
+}
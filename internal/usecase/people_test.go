@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"movieService/internal/entities"
+	"movieService/internal/repository/postgres"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// filmographyRepository запоминает возрастной фильтр, с которым запрошена фильмография.
+type filmographyRepository struct {
+	postgres.InterfaceRepository
+
+	age entities.AgeFilter
+}
+
+func (r *filmographyRepository) GetPerson(_ context.Context, personID int) (*entities.Person, error) {
+	return &entities.Person{ID: personID}, nil
+}
+
+func (r *filmographyRepository) ListFilmography(_ context.Context, _ int, age entities.AgeFilter) ([]*entities.FilmographyEntry, error) {
+	r.age = age
+	return nil, nil
+}
+
+func TestListFilmographyAgeFilter(t *testing.T) {
+	maxAge := int32(12)
+	cases := []struct {
+		name   string
+		claims *JWT.Claims
+		want   entities.AgeFilter
+	}{
+		{name: "anonymous", want: entities.AgeFilter{Country: "RU"}},
+		{name: "adult with country", claims: &JWT.Claims{UserID: 1, Country: "de"}, want: entities.AgeFilter{Country: "DE"}},
+		{name: "kid", claims: &JWT.Claims{UserID: 2, MaxAge: &maxAge}, want: entities.AgeFilter{Country: "RU", MaxAge: ptr(12)}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			repo := &filmographyRepository{}
+			uc := newTestUsecase(repo)
+			uc.cfg.Certification.DefaultCountry = "ru"
+			ctx := context.Background()
+			if c.claims != nil {
+				ctx = JWT.WithClaims(ctx, c.claims)
+			}
+
+			_, err := uc.ListFilmography(ctx, &protos.ListFilmographyRequest{PersonId: 3})
+			require.NoError(t, err)
+			assert.Equal(t, c.want, repo.age)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// movieTransitions — из каких состояний публикации разрешён переход в каждое состояние.
+// Повторное планирование меняет время публикации запланированного фильма.
+var movieTransitions = map[string][]string{
+	entities.MovieStatusDraft:     {entities.MovieStatusScheduled, entities.MovieStatusPublished, entities.MovieStatusArchived},
+	entities.MovieStatusScheduled: {entities.MovieStatusDraft, entities.MovieStatusScheduled, entities.MovieStatusArchived},
+	entities.MovieStatusPublished: {entities.MovieStatusDraft, entities.MovieStatusScheduled, entities.MovieStatusArchived},
+	entities.MovieStatusArchived:  {entities.MovieStatusScheduled, entities.MovieStatusPublished},
+}
+
+// PublishMovie публикует фильм сразу, из черновика, расписания или архива.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма и необязательным временем снятия с публикации.
+//
+// Возвращает:
+//   - Movie: DTO с опубликованным фильмом.
+//   - error: ошибку валидации unpublish_at, ошибку, если фильм не найден или уже опубликован, или сбой БД.
+func (uc *Usecase) PublishMovie(ctx context.Context, req *protos.PublishMovieRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.PublishMovie: входной запрос", zap.Int32("id", req.GetId()))
+
+	now := time.Now()
+	movie := &entities.Movie{
+		ID:          int(req.GetId()),
+		Status:      entities.MovieStatusPublished,
+		PublishAt:   &now,
+		UnpublishAt: optionalTime(req.GetUnpublishAt()),
+	}
+	if err := validateSchedule(now, movie); err != nil {
+		return nil, err
+	}
+	return uc.transitionMovie(ctx, "PublishMovie", movie)
+}
+
+// ScheduleMovie планирует публикацию фильма на publish_at; в это время фильм становится виден всем.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма, временем публикации и необязательным временем снятия с публикации.
+//
+// Возвращает:
+//   - Movie: DTO с запланированным фильмом.
+//   - error: ошибку валидации времени, ошибку, если фильм не найден или уже опубликован, или сбой БД.
+func (uc *Usecase) ScheduleMovie(ctx context.Context, req *protos.ScheduleMovieRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.ScheduleMovie: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.Time("publish_at", req.GetPublishAt().AsTime()),
+	)
+
+	movie := &entities.Movie{
+		ID:          int(req.GetId()),
+		Status:      entities.MovieStatusScheduled,
+		PublishAt:   optionalTime(req.GetPublishAt()),
+		UnpublishAt: optionalTime(req.GetUnpublishAt()),
+	}
+	if err := validateSchedule(time.Now(), movie); err != nil {
+		return nil, err
+	}
+	return uc.transitionMovie(ctx, "ScheduleMovie", movie)
+}
+
+// ArchiveMovie снимает фильм с публикации или отменяет запланированную публикацию.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма.
+//
+// Возвращает:
+//   - Movie: DTO с фильмом в архиве.
+//   - error: ошибку, если фильм не найден или уже в архиве, или сбой БД.
+func (uc *Usecase) ArchiveMovie(ctx context.Context, req *protos.ArchiveMovieRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.ArchiveMovie: входной запрос", zap.Int32("id", req.GetId()))
+
+	current, err := uc.repo.GetMovie(ctx, int(req.GetId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ArchiveMovie: ошибка получения фильма", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+	now := time.Now()
+	return uc.transitionMovie(ctx, "ArchiveMovie", &entities.Movie{
+		ID:          current.ID,
+		Status:      entities.MovieStatusArchived,
+		PublishAt:   current.PublishAt,
+		UnpublishAt: &now,
+	})
+}
+
+// RevertMovieToDraft возвращает фильм в черновики и сбрасывает расписание публикации.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма.
+//
+// Возвращает:
+//   - Movie: DTO с фильмом-черновиком.
+//   - error: ошибку, если фильм не найден или уже черновик, или сбой БД.
+func (uc *Usecase) RevertMovieToDraft(ctx context.Context, req *protos.RevertMovieToDraftRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.RevertMovieToDraft: входной запрос", zap.Int32("id", req.GetId()))
+
+	return uc.transitionMovie(ctx, "RevertMovieToDraft", &entities.Movie{
+		ID:     int(req.GetId()),
+		Status: entities.MovieStatusDraft,
+	})
+}
+
+// transitionMovie переводит фильм в movie.Status, если это разрешено movieTransitions.
+func (uc *Usecase) transitionMovie(ctx context.Context, op string, movie *entities.Movie) (*protos.Movie, error) {
+	updated, err := uc.repo.TransitionMovie(ctx, movie, movieTransitions[movie.Status])
+	if err != nil {
+		uc.logger(ctx).Error("Usecase."+op+": ошибка смены состояния фильма", zap.Error(err), zap.Int("id", movie.ID))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase."+op+": состояние фильма изменено",
+		zap.Int("id", updated.ID),
+		zap.String("status", updated.Status),
+	)
+	return movieToProto(updated), nil
+}
+
+// canSeeUnpublished сообщает, видит ли пользователь из контекста черновики, запланированные
+// и архивные фильмы. Анонимным пользователям и зрителям видны только опубликованные.
+func canSeeUnpublished(ctx context.Context) bool {
+	claims, ok := JWT.ClaimsFromContext(ctx)
+	return ok && JWT.HasRole(claims.Role, JWT.RoleAdmin)
+}
+
+// checkTargetVisible возвращает NotFound, если объект оценки, комментария или перевода — фильм,
+// который пользователь из контекста не видит: не опубликованный или скрытый возрастным
+// ограничением детского профиля. Его содержимое скрыто вместе с ним.
+// У сериалов и эпизодов состояний публикации нет.
+func (uc *Usecase) checkTargetVisible(ctx context.Context, target entities.Target) error {
+	if target.MovieID == 0 {
+		return nil
+	}
+	age, err := uc.ageFilter(ctx, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	if canSeeUnpublished(ctx) && !age.Active() {
+		return nil
+	}
+	movie, err := uc.repo.GetMovie(ctx, target.MovieID)
+	if err != nil {
+		return err
+	}
+	if !movie.IsPublic(time.Now()) && !canSeeUnpublished(ctx) {
+		return errs.NotFound("not found")
+	}
+	if !age.Allows(movie.Certifications) {
+		return errs.NotFound("not found")
+	}
+	return nil
+}
+
+// optionalTime переводит необязательный Timestamp из запроса; nil — время не задано.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// timestampOrNil — обратное к optionalTime преобразование для ответа.
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/repository/postgres"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// unpublishedRepository хранит один фильм в заданном состоянии и считает обращения к оценкам,
+// комментариям и переводам, которые не должны происходить для скрытого фильма.
+type unpublishedRepository struct {
+	postgres.InterfaceRepository
+
+	movie *entities.Movie
+	calls int
+}
+
+func (r *unpublishedRepository) GetMovie(_ context.Context, movieID int) (*entities.Movie, error) {
+	if movieID != r.movie.ID {
+		return nil, errs.NotFound("not found")
+	}
+	return r.movie, nil
+}
+
+func (r *unpublishedRepository) ListRatings(context.Context, *entities.ListRatingsRequest) (*entities.ListRatingsResponse, error) {
+	r.calls++
+	return &entities.ListRatingsResponse{}, nil
+}
+
+func (r *unpublishedRepository) GetRating(_ context.Context, target entities.Target, ratingID int) (*entities.Rating, error) {
+	r.calls++
+	return &entities.Rating{ID: ratingID, MovieID: target.MovieID}, nil
+}
+
+func (r *unpublishedRepository) GetRatingByUser(_ context.Context, target entities.Target, userID int) (*entities.Rating, error) {
+	r.calls++
+	return &entities.Rating{ID: 1, MovieID: target.MovieID, UserID: userID}, nil
+}
+
+func (r *unpublishedRepository) CreateRating(_ context.Context, rating *entities.Rating) (*entities.Rating, bool, error) {
+	r.calls++
+	return rating, true, nil
+}
+
+func (r *unpublishedRepository) ListComments(context.Context, *entities.ListCommentsRequest) (*entities.ListCommentsResponse, error) {
+	r.calls++
+	return &entities.ListCommentsResponse{}, nil
+}
+
+func (r *unpublishedRepository) GetComment(_ context.Context, target entities.Target, commentID int) (*entities.Comment, error) {
+	r.calls++
+	return &entities.Comment{ID: commentID, MovieID: target.MovieID, UserID: 5}, nil
+}
+
+func (r *unpublishedRepository) CreateComment(_ context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	r.calls++
+	return comment, nil
+}
+
+func (r *unpublishedRepository) UpdateComment(_ context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	r.calls++
+	return comment, nil
+}
+
+func (r *unpublishedRepository) ListMovieTranslations(context.Context, int) ([]*entities.MovieTranslation, error) {
+	r.calls++
+	return nil, nil
+}
+
+// movieContentCalls обращается к оценкам, комментариям и переводам фильма 7 всеми способами.
+func movieContentCalls(uc *Usecase) map[string]func(context.Context) error {
+	return map[string]func(context.Context) error{
+		"ListRatings": func(ctx context.Context) error {
+			_, err := uc.ListRatings(ctx, &protos.ListRatingsRequest{MovieId: 7})
+			return err
+		},
+		"GetRating": func(ctx context.Context) error {
+			_, err := uc.GetRating(ctx, &protos.GetRatingRequest{MovieId: 7, RatingId: 1})
+			return err
+		},
+		"CreateRating": func(ctx context.Context) error {
+			_, err := uc.CreateRating(ctx, &protos.CreateRatingRequest{MovieId: 7, Score: 9})
+			return err
+		},
+		"GetMyRating": func(ctx context.Context) error {
+			_, err := uc.GetMyRating(ctx, &protos.GetMyRatingRequest{MovieId: 7})
+			return err
+		},
+		"ListComments": func(ctx context.Context) error {
+			_, err := uc.ListComments(ctx, &protos.ListCommentsRequest{MovieId: 7})
+			return err
+		},
+		"GetComment": func(ctx context.Context) error {
+			_, err := uc.GetComment(ctx, &protos.GetCommentRequest{MovieId: 7, CommentId: 1})
+			return err
+		},
+		"CreateComment": func(ctx context.Context) error {
+			_, err := uc.CreateComment(ctx, &protos.CreateCommentRequest{MovieId: 7, Text: "spoiler"})
+			return err
+		},
+		"EditComment": func(ctx context.Context) error {
+			_, err := uc.EditComment(ctx, &protos.EditCommentRequest{MovieId: 7, CommentId: 1, Text: "spoiler"})
+			return err
+		},
+		"ListMovieTranslations": func(ctx context.Context) error {
+			_, err := uc.ListMovieTranslations(ctx, &protos.ListMovieTranslationsRequest{MovieId: 7})
+			return err
+		},
+	}
+}
+
+func TestUnpublishedMovieContentIsHidden(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	hidden := []*entities.Movie{
+		{ID: 7, Status: entities.MovieStatusDraft},
+		{ID: 7, Status: entities.MovieStatusScheduled, PublishAt: &future},
+		{ID: 7, Status: entities.MovieStatusArchived},
+		{ID: 7, Status: entities.MovieStatusPublished, UnpublishAt: &past},
+	}
+	viewers := map[string]context.Context{
+		"anonymous": context.Background(),
+		"viewer":    userContext(5, JWT.RoleViewer),
+		"moderator": userContext(5, JWT.RoleModerator),
+	}
+
+	for _, movie := range hidden {
+		repo := &unpublishedRepository{movie: movie}
+		uc := newTestUsecase(repo)
+		for who, ctx := range viewers {
+			for name, call := range movieContentCalls(uc) {
+				err := call(ctx)
+				// анонимному пользователю запись недоступна ещё до проверки фильма
+				if errs.CodeOf(err) == errs.CodeUnauthenticated {
+					continue
+				}
+				assert.ErrorIs(t, err, errs.ErrNotFound, "%s %s on %s movie", who, name, movie.Status)
+			}
+		}
+		assert.Zero(t, repo.calls, movie.Status)
+	}
+}
+
+func TestUnpublishedMovieContentIsVisibleToAdmin(t *testing.T) {
+	repo := &unpublishedRepository{movie: &entities.Movie{ID: 7, Status: entities.MovieStatusDraft}}
+	uc := newTestUsecase(repo)
+	ctx := JWT.WithClaims(context.Background(), &JWT.Claims{UserID: 5, Role: JWT.RoleAdmin})
+
+	for name, call := range movieContentCalls(uc) {
+		require.NoError(t, call(ctx), name)
+	}
+	assert.NotZero(t, repo.calls)
+}
+
+func TestPublishedMovieContentIsVisible(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	for _, movie := range []*entities.Movie{
+		{ID: 7, Status: entities.MovieStatusPublished},
+		// время запланированной публикации наступило, даже если фоновая задача ещё не отработала
+		{ID: 7, Status: entities.MovieStatusScheduled, PublishAt: &past},
+	} {
+		uc := newTestUsecase(&unpublishedRepository{movie: movie})
+		for name, call := range movieContentCalls(uc) {
+			require.NoError(t, call(userContext(5, JWT.RoleViewer)), "%s on %s movie", name, movie.Status)
+		}
+	}
+}
+
+func TestRestrictedMovieContentIsHiddenFromKid(t *testing.T) {
+	maxAge := int32(12)
+	kid := JWT.WithClaims(context.Background(), &JWT.Claims{UserID: 5, Role: JWT.RoleViewer, MaxAge: &maxAge})
+
+	for _, c := range []struct {
+		minAge int
+		hidden bool
+	}{
+		{minAge: 18, hidden: true},
+		{minAge: 6, hidden: false},
+	} {
+		repo := &unpublishedRepository{movie: &entities.Movie{
+			ID:             7,
+			Status:         entities.MovieStatusPublished,
+			Certifications: []entities.Certification{{Country: "RU", Certification: "x", MinAge: c.minAge}},
+		}}
+		uc := newTestUsecase(repo)
+		uc.cfg.Certification.DefaultCountry = "RU"
+
+		for name, call := range movieContentCalls(uc) {
+			err := call(kid)
+			if c.hidden {
+				assert.ErrorIs(t, err, errs.ErrNotFound, "%s on %d+ movie", name, c.minAge)
+			} else {
+				assert.NoError(t, err, "%s on %d+ movie", name, c.minAge)
+			}
+		}
+		assert.Equal(t, c.hidden, repo.calls == 0, c.minAge)
+	}
+}
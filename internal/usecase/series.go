@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListSeries возвращает постраничный список сериалов, отсортированный по названию.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с параметрами пагинации.
+//
+// Возвращает:
+//   - ListSeriesResponse: DTO со списком сериалов и общим количеством.
+//   - error: ошибку выполнения.
+func (uc *Usecase) ListSeries(ctx context.Context, req *protos.ListSeriesRequest) (*protos.ListSeriesResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListSeries: входной запрос",
+		zap.Int32("page", req.GetPage()),
+		zap.Int32("per_page", req.GetPerPage()),
+	)
+
+	listRes, err := uc.repo.ListSeries(ctx, &entities.ListSeriesRequest{
+		Page:    int(req.GetPage()),
+		PerPage: int(req.GetPerPage()),
+	})
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListSeries: ошибка получения сериалов", zap.Error(err))
+		return nil, err
+	}
+
+	seriesProto := make([]*protos.Series, 0, len(listRes.Series))
+	for _, s := range listRes.Series {
+		seriesProto = append(seriesProto, seriesToProto(s))
+	}
+
+	uc.logger(ctx).Info("Usecase.ListSeries: сформирован ответ",
+		zap.Int("returned", len(seriesProto)),
+		zap.Int("total", listRes.Total),
+	)
+	return &protos.ListSeriesResponse{Series: seriesProto, Total: int32(listRes.Total)}, nil
+}
+
+// GetSeries возвращает сериал по его ID вместе со списком сезонов.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с идентификатором сериала.
+//
+// Возвращает:
+//   - Series: DTO с сериалом, сезонами и агрегатами оценок.
+//   - error: ошибку, если сериал не найден или сбой БД.
+func (uc *Usecase) GetSeries(ctx context.Context, req *protos.GetSeriesRequest) (*protos.Series, error) {
+	uc.logger(ctx).Info("Usecase.GetSeries: входной запрос", zap.Int32("id", req.GetId()))
+
+	series, err := uc.repo.GetSeries(ctx, int(req.GetId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.GetSeries: ошибка получения сериала", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+	return seriesToProto(series), nil
+}
+
+// ListEpisodes возвращает эпизоды сериала по порядку сезонов и номеров, всех сезонов или одного.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID сериала и номером сезона (0 — все сезоны).
+//
+// Возвращает:
+//   - ListEpisodesResponse: DTO со списком эпизодов.
+//   - error: ошибку, если сериал не найден или сбой БД.
+func (uc *Usecase) ListEpisodes(ctx context.Context, req *protos.ListEpisodesRequest) (*protos.ListEpisodesResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListEpisodes: входной запрос",
+		zap.Int32("series_id", req.GetSeriesId()),
+		zap.Int32("season", req.GetSeason()),
+	)
+	if req.GetSeason() < 0 {
+		return nil, errs.InvalidArgument("season must not be negative")
+	}
+
+	episodes, err := uc.repo.ListEpisodes(ctx, int(req.GetSeriesId()), int(req.GetSeason()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListEpisodes: ошибка получения эпизодов", zap.Error(err),
+			zap.Int32("series_id", req.GetSeriesId()),
+		)
+		return nil, err
+	}
+
+	episodesProto := make([]*protos.Episode, 0, len(episodes))
+	for _, e := range episodes {
+		episodesProto = append(episodesProto, episodeToProto(e))
+	}
+
+	uc.logger(ctx).Info("Usecase.ListEpisodes: сформирован ответ", zap.Int("returned", len(episodesProto)))
+	return &protos.ListEpisodesResponse{Episodes: episodesProto}, nil
+}
+
+// GetEpisode возвращает эпизод по его ID.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с идентификатором эпизода.
+//
+// Возвращает:
+//   - Episode: DTO с эпизодом, номерами сезона и эпизода и агрегатами оценок.
+//   - error: ошибку, если эпизод не найден или сбой БД.
+func (uc *Usecase) GetEpisode(ctx context.Context, req *protos.GetEpisodeRequest) (*protos.Episode, error) {
+	uc.logger(ctx).Info("Usecase.GetEpisode: входной запрос", zap.Int32("id", req.GetId()))
+
+	episode, err := uc.repo.GetEpisode(ctx, int(req.GetId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.GetEpisode: ошибка получения эпизода", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+	return episodeToProto(episode), nil
+}
+
+// GetNextEpisode возвращает эпизод, который идёт после указанного: следующий в сезоне или первый
+// эпизод следующего сезона.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с идентификатором текущего эпизода.
+//
+// Возвращает:
+//   - GetNextEpisodeResponse: DTO со следующим эпизодом; episode не задан, если текущий — последний.
+//   - error: ошибку, если текущий эпизод не найден, или сбой БД.
+func (uc *Usecase) GetNextEpisode(ctx context.Context, req *protos.GetNextEpisodeRequest) (*protos.GetNextEpisodeResponse, error) {
+	uc.logger(ctx).Info("Usecase.GetNextEpisode: входной запрос", zap.Int32("episode_id", req.GetEpisodeId()))
+
+	// 1. Несуществующий эпизод — 404, а не «следующего нет»
+	if _, err := uc.repo.GetEpisode(ctx, int(req.GetEpisodeId())); err != nil {
+		uc.logger(ctx).Error("Usecase.GetNextEpisode: ошибка получения эпизода", zap.Error(err),
+			zap.Int32("episode_id", req.GetEpisodeId()),
+		)
+		return nil, err
+	}
+
+	// 2. Ищем следующий эпизод того же сериала
+	next, err := uc.repo.GetNextEpisode(ctx, int(req.GetEpisodeId()))
+	if errors.Is(err, errs.ErrNotFound) {
+		return &protos.GetNextEpisodeResponse{}, nil
+	}
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.GetNextEpisode: ошибка получения следующего эпизода", zap.Error(err),
+			zap.Int32("episode_id", req.GetEpisodeId()),
+		)
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.GetNextEpisode: сформирован ответ", zap.Int("next_id", next.ID))
+	return &protos.GetNextEpisodeResponse{Episode: episodeToProto(next)}, nil
+}
+
+// seriesToProto маппит сущность сериала в Protobuf вместе с сезонами, если они загружены.
+func seriesToProto(s *entities.Series) *protos.Series {
+	seasons := make([]*protos.Season, 0, len(s.Seasons))
+	for _, sn := range s.Seasons {
+		seasons = append(seasons, &protos.Season{
+			Id:           int32(sn.ID),
+			SeriesId:     int32(sn.SeriesID),
+			Number:       int32(sn.Number),
+			Title:        sn.Title,
+			EpisodeCount: int32(sn.EpisodeCount),
+		})
+	}
+	return &protos.Series{
+		Id:           int32(s.ID),
+		Title:        s.Title,
+		CoverUrl:     s.CoverURL,
+		Description:  s.Description,
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+		SeasonCount:  int32(s.SeasonCount),
+		EpisodeCount: int32(s.EpisodeCount),
+		RatingStats:  ratingStatsToProto(s.RatingStats),
+		Seasons:      seasons,
+	}
+}
+
+// episodeToProto маппит сущность эпизода в Protobuf.
+func episodeToProto(e *entities.Episode) *protos.Episode {
+	return &protos.Episode{
+		Id:           int32(e.ID),
+		SeriesId:     int32(e.SeriesID),
+		SeasonId:     int32(e.SeasonID),
+		SeasonNumber: int32(e.SeasonNumber),
+		Number:       int32(e.Number),
+		Title:        e.Title,
+		VideoUrl:     e.VideoURL,
+		Description:  e.Description,
+		ReleaseDate:  timestamppb.New(e.ReleaseDate),
+		DurationMin:  int32(e.DurationMin),
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+		UpdatedAt:    timestamppb.New(e.UpdatedAt),
+		RatingStats:  ratingStatsToProto(e.RatingStats),
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"movieService/internal/entities"
+	"movieService/internal/repository/postgres"
+	JWT "movieService/pkg/jwt"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+func TestTargetOf(t *testing.T) {
+	cases := []struct {
+		name                         string
+		movieID, seriesID, episodeID int32
+		want                         entities.Target
+		wantErr                      error
+	}{
+		{name: "movie", movieID: 1, want: entities.Target{MovieID: 1}},
+		{name: "series", seriesID: 2, want: entities.Target{SeriesID: 2}},
+		{name: "episode", episodeID: 3, want: entities.Target{EpisodeID: 3}},
+		// без идентификаторов объект — фильм 0, которого нет, репозиторий ответит 404
+		{name: "none", want: entities.Target{}},
+		{name: "movie and series", movieID: 1, seriesID: 2, wantErr: ErrAmbiguousTarget},
+		{name: "series and episode", seriesID: 2, episodeID: 3, wantErr: ErrAmbiguousTarget},
+		{name: "all three", movieID: 1, seriesID: 2, episodeID: 3, wantErr: ErrAmbiguousTarget},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			target, err := targetOf(c.movieID, c.seriesID, c.episodeID)
+			if c.wantErr != nil {
+				assert.ErrorIs(t, err, c.wantErr)
+				assert.Zero(t, target)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.want, target)
+		})
+	}
+}
+
+// targetsRepository запоминает, для какого объекта вызваны методы оценок и комментариев.
+type targetsRepository struct {
+	postgres.InterfaceRepository
+
+	targets []entities.Target
+}
+
+func (r *targetsRepository) ListRatings(_ context.Context, req *entities.ListRatingsRequest) (*entities.ListRatingsResponse, error) {
+	r.targets = append(r.targets, req.Target)
+	return &entities.ListRatingsResponse{}, nil
+}
+
+func (r *targetsRepository) CreateRating(_ context.Context, rating *entities.Rating) (*entities.Rating, bool, error) {
+	r.targets = append(r.targets, rating.Target())
+	return rating, true, nil
+}
+
+func (r *targetsRepository) ListComments(_ context.Context, req *entities.ListCommentsRequest) (*entities.ListCommentsResponse, error) {
+	r.targets = append(r.targets, req.Target)
+	return &entities.ListCommentsResponse{}, nil
+}
+
+func (r *targetsRepository) CreateComment(_ context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	r.targets = append(r.targets, comment.Target())
+	return comment, nil
+}
+
+func TestRatingsAndCommentsRouteToTarget(t *testing.T) {
+	ctx := JWT.WithClaims(context.Background(), &JWT.Claims{UserID: 5, Role: JWT.RoleViewer})
+
+	for _, want := range []entities.Target{{SeriesID: 2}, {EpisodeID: 3}} {
+		repo := &targetsRepository{}
+		uc := newTestUsecase(repo)
+		series, episode := int32(want.SeriesID), int32(want.EpisodeID)
+
+		_, err := uc.ListRatings(ctx, &protos.ListRatingsRequest{SeriesId: series, EpisodeId: episode})
+		require.NoError(t, err)
+		created, err := uc.CreateRating(ctx, &protos.CreateRatingRequest{SeriesId: series, EpisodeId: episode, Score: 8})
+		require.NoError(t, err)
+		_, err = uc.ListComments(ctx, &protos.ListCommentsRequest{SeriesId: series, EpisodeId: episode})
+		require.NoError(t, err)
+		comment, err := uc.CreateComment(ctx, &protos.CreateCommentRequest{SeriesId: series, EpisodeId: episode, Text: "nice"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []entities.Target{want, want, want, want}, repo.targets)
+		assert.Equal(t, series, created.GetRating().GetSeriesId())
+		assert.Equal(t, episode, created.GetRating().GetEpisodeId())
+		assert.Zero(t, created.GetRating().GetMovieId())
+		assert.Equal(t, series, comment.GetComment().GetSeriesId())
+		assert.Equal(t, episode, comment.GetComment().GetEpisodeId())
+	}
+}
+
+func TestAmbiguousTargetIsRejectedBeforeRepository(t *testing.T) {
+	ctx := JWT.WithClaims(context.Background(), &JWT.Claims{UserID: 5, Role: JWT.RoleViewer})
+	repo := &targetsRepository{}
+	uc := newTestUsecase(repo)
+
+	_, err := uc.ListRatings(ctx, &protos.ListRatingsRequest{MovieId: 1, SeriesId: 2})
+	assert.ErrorIs(t, err, ErrAmbiguousTarget)
+	_, err = uc.CreateRating(ctx, &protos.CreateRatingRequest{SeriesId: 2, EpisodeId: 3, Score: 8})
+	assert.ErrorIs(t, err, ErrAmbiguousTarget)
+	_, err = uc.CreateComment(ctx, &protos.CreateCommentRequest{MovieId: 1, EpisodeId: 3, Text: "nice"})
+	assert.ErrorIs(t, err, ErrAmbiguousTarget)
+	assert.Empty(t, repo.targets)
+}
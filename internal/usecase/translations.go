@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"movieService/internal/entities"
+	"movieService/internal/locale"
+	protos "movieService/pkg/proto/gen/go"
+)
+
+// ListMovieTranslations возвращает все переводы названия и описания фильма.
+// Переводы неопубликованного фильма видны только администраторам.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма.
+//
+// Возвращает:
+//   - ListMovieTranslationsResponse: DTO с переводами по возрастанию локали.
+//   - error: ошибку, если фильм не найден или сбой БД.
+func (uc *Usecase) ListMovieTranslations(ctx context.Context, req *protos.ListMovieTranslationsRequest) (*protos.ListMovieTranslationsResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListMovieTranslations: входной запрос", zap.Int32("movie_id", req.GetMovieId()))
+	if err := uc.checkTargetVisible(ctx, entities.Target{MovieID: int(req.GetMovieId())}); err != nil {
+		uc.logger(ctx).Info("Usecase.ListMovieTranslations: фильм недоступен", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	translations, err := uc.repo.ListMovieTranslations(ctx, int(req.GetMovieId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListMovieTranslations: ошибка получения переводов", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	translationsProto := make([]*protos.MovieTranslation, 0, len(translations))
+	for _, t := range translations {
+		translationsProto = append(translationsProto, movieTranslationToProto(t))
+	}
+	return &protos.ListMovieTranslationsResponse{Translations: translationsProto}, nil
+}
+
+// SetMovieTranslation создаёт или заменяет перевод названия и описания фильма на указанную локаль.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма, локалью, названием и описанием.
+//
+// Возвращает:
+//   - MovieTranslation: DTO с сохранённым переводом.
+//   - error: ошибку валидации (в том числе для локали по умолчанию), ошибку, если фильм не найден, или сбой БД.
+func (uc *Usecase) SetMovieTranslation(ctx context.Context, req *protos.SetMovieTranslationRequest) (*protos.MovieTranslation, error) {
+	uc.logger(ctx).Info("Usecase.SetMovieTranslation: входной запрос",
+		zap.Int32("movie_id", req.GetMovieId()),
+		zap.String("locale", req.GetLocale()),
+	)
+
+	tag, _ := locale.Normalize(req.GetLocale())
+	translation := &entities.MovieTranslation{
+		MovieID:     int(req.GetMovieId()),
+		Locale:      tag,
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	}
+	if err := uc.validateMovieTranslation(translation); err != nil {
+		return nil, err
+	}
+
+	saved, err := uc.repo.SetMovieTranslation(ctx, translation)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.SetMovieTranslation: ошибка сохранения перевода", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.SetMovieTranslation: перевод сохранён",
+		zap.Int("movie_id", saved.MovieID),
+		zap.String("locale", saved.Locale),
+	)
+	return movieTranslationToProto(saved), nil
+}
+
+// DeleteMovieTranslation удаляет перевод фильма на указанную локаль.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма и локалью.
+//
+// Возвращает:
+//   - Empty: пустой ответ при успешном удалении.
+//   - error: ошибку валидации локали, ошибку, если перевода нет, или сбой БД.
+func (uc *Usecase) DeleteMovieTranslation(ctx context.Context, req *protos.DeleteMovieTranslationRequest) (*emptypb.Empty, error) {
+	uc.logger(ctx).Info("Usecase.DeleteMovieTranslation: входной запрос",
+		zap.Int32("movie_id", req.GetMovieId()),
+		zap.String("locale", req.GetLocale()),
+	)
+
+	tag, err := validateLocale(req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.DeleteMovieTranslation(ctx, int(req.GetMovieId()), tag); err != nil {
+		uc.logger(ctx).Error("Usecase.DeleteMovieTranslation: ошибка удаления перевода", zap.Error(err), zap.Int32("movie_id", req.GetMovieId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.DeleteMovieTranslation: перевод удалён",
+		zap.Int32("movie_id", req.GetMovieId()),
+		zap.String("locale", tag),
+	)
+	return &emptypb.Empty{}, nil
+}
+
+// defaultLocale — локаль исходного текста фильмов из конфига.
+func (uc *Usecase) defaultLocale() string {
+	tag, _ := locale.Normalize(uc.cfg.Locale.Default)
+	return tag
+}
+
+// localize подменяет названия и описания фильмов переводом на самый предпочтительный
+// из языков preferred (значение Accept-Language), для которого перевод есть.
+// У остальных фильмов остаётся исходный текст на локали по умолчанию.
+func (uc *Usecase) localize(ctx context.Context, preferred string, movies ...*entities.Movie) error {
+	for _, m := range movies {
+		m.Locale = uc.defaultLocale()
+	}
+	locales := locale.Negotiate(preferred, uc.defaultLocale())
+	if len(locales) == 0 {
+		return nil
+	}
+	return uc.repo.TranslateMovies(ctx, movies, locales)
+}
+
+func movieTranslationToProto(t *entities.MovieTranslation) *protos.MovieTranslation {
+	return &protos.MovieTranslation{
+		MovieId:     int32(t.MovieID),
+		Locale:      t.Locale,
+		Title:       t.Title,
+		Description: t.Description,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
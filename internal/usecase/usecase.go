@@ -2,11 +2,18 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"movieService/internal/config"
 	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/logger"
 	"movieService/internal/repository/postgres"
 	JWT "movieService/pkg/jwt"
 	protos "movieService/pkg/proto/gen/go"
@@ -15,6 +22,24 @@ import (
 var _ postgres.InterfaceRepository = (*postgres.Repository)(nil)
 var _ JWT.InterfaceJWT = (*JWT.ServiceJWT)(nil)
 
+var (
+	// ErrEmptyUpdateMask возвращается, если в PatchMovie не передан ни один путь update_mask.
+	ErrEmptyUpdateMask = errs.New(errs.CodeInvalidArgument, "update_mask is empty")
+	// ErrUnknownUpdateMaskPath возвращается для пути update_mask, которого нет у фильма.
+	ErrUnknownUpdateMaskPath = errs.New(errs.CodeInvalidArgument, "unknown update_mask path")
+	// ErrUnauthenticated возвращается, если в контексте нет пользователя из проверенного токена.
+	ErrUnauthenticated = errs.New(errs.CodeUnauthenticated, "user is not authenticated")
+	// ErrForbidden возвращается при попытке изменить чужую оценку или комментарий.
+	ErrForbidden = errs.New(errs.CodePermissionDenied, "permission denied")
+	// ErrInvalidSort возвращается для неизвестного sort_by или sort_order.
+	ErrInvalidSort = errs.New(errs.CodeInvalidArgument, "invalid sort")
+	// ErrEmptySearchQuery возвращается, если в SearchMovies не передана поисковая строка.
+	ErrEmptySearchQuery = errs.New(errs.CodeInvalidArgument, "search query is empty")
+	// ErrAmbiguousTarget возвращается, если в запросе оценки или комментария задано больше одного
+	// из movie_id, series_id, episode_id.
+	ErrAmbiguousTarget = errs.New(errs.CodeInvalidArgument, "only one of movie_id, series_id, episode_id can be set")
+)
+
 type Usecase struct {
 	cfg  *config.Config
 	log  *zap.Logger
@@ -35,6 +60,11 @@ func NewUsecase(logger *zap.Logger, repo postgres.InterfaceRepository, cfg *conf
 	}, nil
 }
 
+// logger возвращает логгер usecase с полями запроса (request_id, trace_id) из контекста.
+func (uc *Usecase) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, uc.log)
+}
+
 func (uc *Usecase) OnStart(_ context.Context) error { return nil }
 func (uc *Usecase) OnStop(_ context.Context) error  { return nil }
 
@@ -42,24 +72,65 @@ func (uc *Usecase) OnStop(_ context.Context) error  { return nil }
 //
 // Параметры:
 //   - ctx: контекст выполнения, поддерживает отмену и дедлайны.
-//   - req: DTO с параметрами пагинации и фильтрации по жанрам.
+//   - req: DTO с параметрами пагинации, фильтрации по жанрам и участнику (person_id), сортировки (sort_by, sort_order)
+//     и предпочитаемыми языками (locale). Фильтр status доступен только администраторам.
+//     Фильтр по возрастному рейтингу (max_age, certifications, country) для детского профиля
+//     дополняется ограничением из токена, которое запрос ослабить не может.
 //
 // Возвращает:
-//   - ListMoviesResponse: DTO со списком фильмов и общим количеством.
-//   - error: ошибку выполнения, если что-то пошло не так.
+//   - ListMoviesResponse: DTO со списком фильмов, переведённых на лучший доступный язык, и общим количеством.
+//     Администраторы видят фильмы во всех состояниях, остальные — только опубликованные.
+//   - error: ErrInvalidSort для неизвестной сортировки, ошибку для недопустимого фильтра status
+//     или ошибку выполнения, если что-то пошло не так.
 func (uc *Usecase) ListMovies(ctx context.Context, req *protos.ListMoviesRequest) (*protos.ListMoviesResponse, error) {
 	// Логируем входные параметры
-	uc.log.Info("Usecase.ListMovies: входной запрос",
+	uc.logger(ctx).Info("Usecase.ListMovies: входной запрос",
 		zap.Int32("page", req.GetPage()),
 		zap.Int32("per_page", req.GetPerPage()),
 		zap.Any("genre_ids", req.GetGenreIds()),
+		zap.String("sort_by", req.GetSortBy()),
+		zap.String("sort_order", req.GetSortOrder()),
+		zap.Int32("person_id", req.GetPersonId()),
+		zap.String("locale", req.GetLocale()),
+		zap.String("status", req.GetStatus()),
+		zap.Int32p("max_age", req.MaxAge),
+		zap.Strings("certifications", req.GetCertifications()),
+		zap.String("country", req.GetCountry()),
 	)
 	// 1. Маппим Protobuf → Entity
 	// Преобразуем page/per_page и genre_ids из int32 в int
+	sortDesc, err := parseSortOrder(req.GetSortOrder())
+	if err != nil {
+		return nil, err
+	}
+	if sortBy := req.GetSortBy(); sortBy != "" && !slices.Contains(entities.MovieSortFields, sortBy) {
+		return nil, fmt.Errorf("%w: unknown sort_by %q", ErrInvalidSort, sortBy)
+	}
+	includeUnpublished := canSeeUnpublished(ctx)
+	if status := req.GetStatus(); status != "" {
+		if !includeUnpublished {
+			return nil, errs.PermissionDenied("filtering by status requires admin role")
+		}
+		if !slices.Contains(entities.MovieStatuses, status) {
+			return nil, errs.InvalidArgument("unknown status %q", status)
+		}
+	}
+	age, err := uc.ageFilter(ctx, req.GetCountry(), req.MaxAge, req.GetCertifications())
+	if err != nil {
+		return nil, err
+	}
 	listMoviesRq := &entities.ListMoviesRequest{
-		Page:     int(req.GetPage()),
-		PerPage:  int(req.GetPerPage()),
-		GenreIDs: make([]int, len(req.GetGenreIds())),
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+		GenreIDs:  make([]int, len(req.GetGenreIds())),
+		SortBy:    req.GetSortBy(),
+		SortDesc:  sortDesc,
+		PageToken: req.GetPageToken(),
+		PersonID:  int(req.GetPersonId()),
+
+		IncludeUnpublished: includeUnpublished,
+		Status:             req.GetStatus(),
+		Age:                age,
 	}
 
 	for i, gid := range req.GetGenreIds() {
@@ -69,88 +140,84 @@ func (uc *Usecase) ListMovies(ctx context.Context, req *protos.ListMoviesRequest
 	// 2. Вызываем репозиторий
 	listMoveRs, err := uc.repo.ListMovies(ctx, listMoviesRq)
 	if err != nil {
-		uc.log.Error("Usecase.ListMovies: ошибка получения списка фильмов", zap.Error(err))
+		uc.logger(ctx).Error("Usecase.ListMovies: ошибка получения списка фильмов", zap.Error(err))
+		return nil, err
+	}
+	if err := uc.localize(ctx, req.GetLocale(), listMoveRs.Movies...); err != nil {
+		uc.logger(ctx).Error("Usecase.ListMovies: ошибка получения переводов", zap.Error(err))
 		return nil, err
 	}
 
 	// 3. Маппим Entity → Protobuf
 	moviesProto := make([]*protos.Movie, 0, len(listMoveRs.Movies))
 	for _, m := range listMoveRs.Movies {
-		protoGenres := make([]*protos.Genre, 0, len(m.Genres))
-		for _, g := range m.Genres {
-			protoGenres = append(protoGenres, &protos.Genre{
-				Id:   int32(g.ID),
-				Name: g.Name,
-			})
-		}
-
-		moviesProto = append(moviesProto, &protos.Movie{
-			Id:          int32(m.ID),
-			Title:       m.Title,
-			VideoUrl:    m.VideoURL,
-			CoverUrl:    m.CoverURL,
-			Description: m.Description,
-			ReleaseDate: timestamppb.New(m.ReleaseDate),
-			DurationMin: int32(m.DurationMin),
-			Genres:      protoGenres,
-			CreatedAt:   timestamppb.New(m.CreatedAt),
-			UpdatedAt:   timestamppb.New(m.UpdatedAt),
-		})
+		moviesProto = append(moviesProto, movieToProto(m))
 	}
 
 	// 4. Формируем и возвращаем ответ
 	resp := &protos.ListMoviesResponse{
-		Movies: moviesProto,
-		Total:  int32(listMoveRs.Total),
+		Movies:        moviesProto,
+		Total:         int32(listMoveRs.Total),
+		NextPageToken: listMoveRs.NextPageToken,
 	}
-	uc.log.Info("Usecase.ListMovies: сформирован ответ",
+	uc.logger(ctx).Info("Usecase.ListMovies: сформирован ответ",
 		zap.Int("returned", len(moviesProto)),
 		zap.Int("total", listMoveRs.Total),
 	)
 	return resp, nil
 }
 
-// GetMovie возвращает подробную информацию о фильме по его ID.
+// GetMovie возвращает подробную информацию о фильме по его ID, включая титры.
+// Название и описание переводятся на самый предпочтительный из языков locale, для которого есть перевод.
+// Неопубликованный фильм для всех, кроме администраторов, не отличается от несуществующего;
+// так же скрыт от детского профиля фильм, не подходящий под возрастное ограничение из токена.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с идентификатором фильма.
+//   - req: DTO с идентификатором фильма и предпочитаемыми языками.
 //
 // Возвращает:
-//   - Movie: DTO с деталями фильма.
+//   - Movie: DTO с деталями фильма и локалью текста.
 //   - error: ошибку, если фильм не найден или произошёл сбой БД.
 func (uc *Usecase) GetMovie(ctx context.Context, req *protos.GetMovieRequest) (*protos.Movie, error) {
-	uc.log.Info("Usecase.GetMovie: входной запрос", zap.Int("id", int(req.GetId())))
+	uc.logger(ctx).Info("Usecase.GetMovie: входной запрос", zap.Int("id", int(req.GetId())), zap.String("locale", req.GetLocale()))
 
 	// 1. Получаем сущность фильма из репозитория
 	movieEntity, err := uc.repo.GetMovie(ctx, int(req.GetId()))
 	if err != nil {
-		uc.log.Error("Usecase.GetMovie: ошибка получения фильма", zap.Error(err), zap.Int("id", int(req.GetId())))
+		uc.logger(ctx).Error("Usecase.GetMovie: ошибка получения фильма", zap.Error(err), zap.Int("id", int(req.GetId())))
 		return nil, err
 	}
-	protoGenres := make([]*protos.Genre, 0, len(movieEntity.Genres))
-	for _, g := range movieEntity.Genres {
-		protoGenres = append(protoGenres, &protos.Genre{
-			Id:   int32(g.ID),
-			Name: g.Name,
-		})
+	if !movieEntity.IsPublic(time.Now()) && !canSeeUnpublished(ctx) {
+		uc.logger(ctx).Info("Usecase.GetMovie: фильм не опубликован",
+			zap.Int("id", movieEntity.ID),
+			zap.String("status", movieEntity.Status),
+		)
+		return nil, errs.NotFound("not found")
+	}
+	if claims, ok := JWT.ClaimsFromContext(ctx); ok && claims.MaxAge != nil {
+		age, err := uc.ageFilter(ctx, "", nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !age.Allows(movieEntity.Certifications) {
+			uc.logger(ctx).Info("Usecase.GetMovie: фильм скрыт возрастным ограничением",
+				zap.Int("id", movieEntity.ID),
+				zap.String("country", age.Country),
+				zap.Int32("max_age", *claims.MaxAge),
+			)
+			return nil, errs.NotFound("not found")
+		}
+	}
+	if err := uc.localize(ctx, req.GetLocale(), movieEntity); err != nil {
+		uc.logger(ctx).Error("Usecase.GetMovie: ошибка получения перевода", zap.Error(err), zap.Int("id", int(req.GetId())))
+		return nil, err
 	}
 
 	// 2. Маппим Entity → Protobuf
-	movieProto := &protos.Movie{
-		Id:          int32(movieEntity.ID),
-		Title:       movieEntity.Title,
-		VideoUrl:    movieEntity.VideoURL,
-		CoverUrl:    movieEntity.CoverURL,
-		Description: movieEntity.Description,
-		ReleaseDate: timestamppb.New(movieEntity.ReleaseDate),
-		DurationMin: int32(movieEntity.DurationMin),
-		Genres:      protoGenres,
-		CreatedAt:   timestamppb.New(movieEntity.CreatedAt),
-		UpdatedAt:   timestamppb.New(movieEntity.UpdatedAt),
-	}
+	movieProto := movieToProto(movieEntity)
 
-	uc.log.Info("Usecase.GetMovie: сформирован ответ", zap.Int32("id", movieProto.GetId()))
+	uc.logger(ctx).Info("Usecase.GetMovie: сформирован ответ", zap.Int32("id", movieProto.GetId()))
 	return movieProto, nil
 }
 
@@ -162,9 +229,9 @@ func (uc *Usecase) GetMovie(ctx context.Context, req *protos.GetMovieRequest) (*
 //
 // Возвращает:
 //   - CreateMovieResponse: DTO с созданным фильмом.
-//   - error: ошибку валидации или сбой при записи в БД.
+//   - error: ошибку валидации с нарушениями по полям или сбой при записи в БД.
 func (uc *Usecase) CreateMovie(ctx context.Context, req *protos.CreateMovieRequest) (*protos.CreateMovieResponse, error) {
-	uc.log.Info("Usecase.CreateMovie: входной запрос",
+	uc.logger(ctx).Info("Usecase.CreateMovie: входной запрос",
 		zap.String("title", req.GetTitle()),
 		zap.Any("genre_ids", req.GetGenreIds()),
 	)
@@ -180,37 +247,30 @@ func (uc *Usecase) CreateMovie(ctx context.Context, req *protos.CreateMovieReque
 		VideoURL:    req.GetVideoUrl(),
 		CoverURL:    req.GetCoverUrl(),
 		Description: req.GetDescription(),
-		ReleaseDate: req.GetReleaseDate().AsTime(),
+		ReleaseDate: dateOrZero(req.GetReleaseDate()),
 		DurationMin: int(req.GetDurationMin()),
 		// предполагаемое поле для жанров в сущности
 		//GenreIDs:    genreIDs,
 	}
+	if err := uc.validateMovie(ctx, movieEntity.ToDTO(genreIDs, nil)); err != nil {
+		return nil, err
+	}
 
 	// 2. Вызываем репозиторий для создания
 	created, err := uc.repo.CreateMovie(ctx, movieEntity, genreIDs)
 	if err != nil {
-		uc.log.Error("Usecase.CreateMovie: ошибка создания фильма", zap.Error(err))
+		uc.logger(ctx).Error("Usecase.CreateMovie: ошибка создания фильма", zap.Error(err))
 		return nil, err
 	}
 
 	// 3. Маппим Entity → Protobuf
-	movieProto := &protos.Movie{
-		Id:          int32(created.ID),
-		Title:       created.Title,
-		VideoUrl:    created.VideoURL,
-		CoverUrl:    created.CoverURL,
-		Description: created.Description,
-		ReleaseDate: timestamppb.New(created.ReleaseDate),
-		DurationMin: int32(created.DurationMin),
-		CreatedAt:   timestamppb.New(created.CreatedAt),
-		UpdatedAt:   timestamppb.New(created.UpdatedAt),
-	}
-
-	uc.log.Info("Usecase.CreateMovie: фильм успешно создан", zap.Int32("id", movieProto.GetId()))
+	movieProto := movieToProto(created)
+
+	uc.logger(ctx).Info("Usecase.CreateMovie: фильм успешно создан", zap.Int32("id", movieProto.GetId()))
 	return &protos.CreateMovieResponse{Movie: movieProto}, nil
 }
 
-// DeleteMovie удаляет фильм по его ID.
+// DeleteMovie мягко удаляет фильм по его ID: фильм скрывается из выдачи до восстановления или очистки.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
@@ -220,7 +280,7 @@ func (uc *Usecase) CreateMovie(ctx context.Context, req *protos.CreateMovieReque
 //   - Empty: пустой ответ при успешном удалении.
 //   - error: ошибку, если фильм не найден или сбой БД.
 func (uc *Usecase) DeleteMovie(ctx context.Context, req *protos.DeleteMovieRequest) (*emptypb.Empty, error) {
-	uc.log.Info("Usecase.DeleteMovie: входной запрос", zap.Int("id", int(req.GetId())))
+	uc.logger(ctx).Info("Usecase.DeleteMovie: входной запрос", zap.Int("id", int(req.GetId())))
 
 	// 1. Маппим Protobuf → Entity
 	movieEntity := &entities.Movie{
@@ -229,352 +289,999 @@ func (uc *Usecase) DeleteMovie(ctx context.Context, req *protos.DeleteMovieReque
 
 	// 2. Вызываем репозиторий для удаления
 	if err := uc.repo.DeleteMovie(ctx, movieEntity); err != nil {
-		uc.log.Error("Usecase.DeleteMovie: ошибка удаления фильма", zap.Error(err), zap.Int("id", movieEntity.ID))
+		uc.logger(ctx).Error("Usecase.DeleteMovie: ошибка удаления фильма", zap.Error(err), zap.Int("id", movieEntity.ID))
 		return nil, err
 	}
 
 	// 3. Возвращаем пустой ответ
-	uc.log.Info("Usecase.DeleteMovie: фильм успешно удалён", zap.Int("id", movieEntity.ID))
+	uc.logger(ctx).Info("Usecase.DeleteMovie: фильм успешно удалён", zap.Int("id", movieEntity.ID))
 	return &emptypb.Empty{}, nil
 }
 
-// ListRatings возвращает постраничный список оценок для указанного фильма.
+// RestoreMovie восстанавливает мягко удалённый фильм.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с ID фильма и параметрами пагинации.
+//   - req: DTO с идентификатором фильма.
+//
+// Возвращает:
+//   - Movie: DTO с восстановленным фильмом.
+//   - error: ошибку, если удалённый фильм с таким ID не найден, или сбой БД.
+func (uc *Usecase) RestoreMovie(ctx context.Context, req *protos.RestoreMovieRequest) (*protos.Movie, error) {
+	uc.logger(ctx).Info("Usecase.RestoreMovie: входной запрос", zap.Int32("id", req.GetId()))
+
+	restored, err := uc.repo.RestoreMovie(ctx, int(req.GetId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.RestoreMovie: ошибка восстановления фильма", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.RestoreMovie: фильм восстановлен", zap.Int("id", restored.ID))
+	return movieToProto(restored), nil
+}
+
+// PurgeMovie окончательно удаляет мягко удалённый фильм вместе с жанрами, оценками и комментариями.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с идентификатором фильма.
+//
+// Возвращает:
+//   - Empty: пустой ответ при успешной очистке.
+//   - error: ошибку, если фильм не найден или ещё не удалён, или сбой БД.
+func (uc *Usecase) PurgeMovie(ctx context.Context, req *protos.PurgeMovieRequest) (*emptypb.Empty, error) {
+	uc.logger(ctx).Info("Usecase.PurgeMovie: входной запрос", zap.Int32("id", req.GetId()))
+
+	if err := uc.repo.PurgeMovie(ctx, int(req.GetId())); err != nil {
+		uc.logger(ctx).Error("Usecase.PurgeMovie: ошибка очистки фильма", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.PurgeMovie: фильм удалён окончательно", zap.Int32("id", req.GetId()))
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateMovie полностью заменяет данные фильма и список его жанров.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма и новыми значениями всех полей.
+//
+// Возвращает:
+//   - UpdateMovieResponse: DTO с обновлённым фильмом.
+//   - error: ошибку, если фильм не найден или сбой БД.
+func (uc *Usecase) UpdateMovie(ctx context.Context, req *protos.UpdateMovieRequest) (*protos.UpdateMovieResponse, error) {
+	uc.logger(ctx).Info("Usecase.UpdateMovie: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.String("title", req.GetTitle()),
+		zap.Any("genre_ids", req.GetGenreIds()),
+	)
+
+	// 1. Маппим Protobuf → Entity
+	genreIDs := make([]int, len(req.GetGenreIds()))
+	for i, gid := range req.GetGenreIds() {
+		genreIDs[i] = int(gid)
+	}
+
+	movieEntity := &entities.Movie{
+		ID:          int(req.GetId()),
+		Title:       req.GetTitle(),
+		VideoURL:    req.GetVideoUrl(),
+		CoverURL:    req.GetCoverUrl(),
+		Description: req.GetDescription(),
+		ReleaseDate: dateOrZero(req.GetReleaseDate()),
+		DurationMin: int(req.GetDurationMin()),
+	}
+	if err := uc.validateMovie(ctx, movieEntity.ToDTO(genreIDs, nil)); err != nil {
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий
+	updated, err := uc.repo.UpdateMovie(ctx, movieEntity, genreIDs)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.UpdateMovie: ошибка обновления фильма", zap.Error(err), zap.Int("id", movieEntity.ID))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.UpdateMovie: фильм успешно обновлён", zap.Int("id", updated.ID))
+	return &protos.UpdateMovieResponse{Movie: movieToProto(updated)}, nil
+}
+
+// PatchMovie частично обновляет фильм: меняются только поля из update_mask.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма, новыми значениями и маской обновляемых полей.
+//
+// Возвращает:
+//   - UpdateMovieResponse: DTO с обновлённым фильмом.
+//   - error: ошибку при пустой или неизвестной маске, если фильм не найден или сбой БД.
+func (uc *Usecase) PatchMovie(ctx context.Context, req *protos.PatchMovieRequest) (*protos.UpdateMovieResponse, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	uc.logger(ctx).Info("Usecase.PatchMovie: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.Strings("update_mask", paths),
+	)
+
+	if len(paths) == 0 {
+		return nil, ErrEmptyUpdateMask
+	}
+
+	// 1. Маппим Protobuf → DTO: nil-поля репозиторий не трогает
+	id := int(req.GetId())
+	patch := &entities.MovieDTO{ID: &id}
+	for _, path := range paths {
+		switch path {
+		case "title":
+			title := req.GetTitle()
+			patch.Title = &title
+		case "video_url":
+			videoURL := req.GetVideoUrl()
+			patch.VideoURL = &videoURL
+		case "cover_url":
+			coverURL := req.GetCoverUrl()
+			patch.CoverURL = &coverURL
+		case "description":
+			description := req.GetDescription()
+			patch.Description = &description
+		case "release_date":
+			releaseDate := dateOrZero(req.GetReleaseDate())
+			patch.ReleaseDate = &releaseDate
+		case "duration_min":
+			durationMin := int(req.GetDurationMin())
+			patch.DurationMin = &durationMin
+		case "genre_ids":
+			patch.GenreIDs = make([]int, len(req.GetGenreIds()))
+			for i, gid := range req.GetGenreIds() {
+				patch.GenreIDs[i] = int(gid)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownUpdateMaskPath, path)
+		}
+	}
+	if err := uc.validateMovie(ctx, patch); err != nil {
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий
+	updated, err := uc.repo.PatchMovie(ctx, patch)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.PatchMovie: ошибка обновления фильма", zap.Error(err), zap.Int("id", id))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.PatchMovie: фильм успешно обновлён", zap.Int("id", updated.ID))
+	return &protos.UpdateMovieResponse{Movie: movieToProto(updated)}, nil
+}
+
+// SearchMovies ищет фильмы по словам из названия и описания с сортировкой по релевантности.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с поисковой строкой (слова ищутся по префиксу), пагинацией, фильтрами по жанрам
+//     и возрастному рейтингу, как в ListMovies.
+//
+// Возвращает:
+//   - SearchMoviesResponse: DTO с найденными фильмами и их общим количеством.
+//   - error: ErrEmptySearchQuery для пустой строки или ошибку выполнения запроса.
+func (uc *Usecase) SearchMovies(ctx context.Context, req *protos.SearchMoviesRequest) (*protos.SearchMoviesResponse, error) {
+	uc.logger(ctx).Info("Usecase.SearchMovies: входной запрос",
+		zap.String("query", req.GetQuery()),
+		zap.Int32("page", req.GetPage()),
+		zap.Int32("per_page", req.GetPerPage()),
+		zap.Any("genre_ids", req.GetGenreIds()),
+		zap.Int32p("max_age", req.MaxAge),
+		zap.Strings("certifications", req.GetCertifications()),
+		zap.String("country", req.GetCountry()),
+	)
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	age, err := uc.ageFilter(ctx, req.GetCountry(), req.MaxAge, req.GetCertifications())
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Маппим Protobuf → Entity
+	searchRq := &entities.SearchMoviesRequest{
+		Query:    req.GetQuery(),
+		Page:     int(req.GetPage()),
+		PerPage:  int(req.GetPerPage()),
+		GenreIDs: make([]int, len(req.GetGenreIds())),
+
+		IncludeUnpublished: canSeeUnpublished(ctx),
+		Age:                age,
+	}
+	for i, gid := range req.GetGenreIds() {
+		searchRq.GenreIDs[i] = int(gid)
+	}
+
+	// 2. Вызываем репозиторий
+	found, err := uc.repo.SearchMovies(ctx, searchRq)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.SearchMovies: ошибка поиска фильмов", zap.Error(err))
+		return nil, err
+	}
+
+	// 3. Маппим Entity → Protobuf, сохраняя порядок по релевантности
+	moviesProto := make([]*protos.Movie, 0, len(found.Movies))
+	for _, m := range found.Movies {
+		moviesProto = append(moviesProto, movieToProto(m))
+	}
+
+	uc.logger(ctx).Info("Usecase.SearchMovies: сформирован ответ",
+		zap.Int("returned", len(moviesProto)),
+		zap.Int("total", found.Total),
+	)
+	return &protos.SearchMoviesResponse{Movies: moviesProto, Total: int32(found.Total)}, nil
+}
+
+// ListRatings возвращает постраничный список оценок фильма, сериала или эпизода.
+// Оценки неопубликованного фильма, как и сам фильм, видны только администраторам.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID фильма (сериала или эпизода) и параметрами пагинации.
 //
 // Возвращает:
 //   - ListRatingsResponse: DTO со списком оценок и общим количеством.
 //   - error: ошибку выполнения.
 func (uc *Usecase) ListRatings(ctx context.Context, req *protos.ListRatingsRequest) (*protos.ListRatingsResponse, error) {
-	uc.log.Info("Usecase.ListRatings: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.ListRatings: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("page", req.GetPage()),
 		zap.Int32("per_page", req.GetPerPage()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.ListRatings: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
 
 	// 1. Маппим Protobuf → Entity
 	listReq := &entities.ListRatingsRequest{
-		MovieID: int(req.GetMovieId()),
-		Page:    int(req.GetPage()),
-		PerPage: int(req.GetPerPage()),
+		Target:    target,
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+		PageToken: req.GetPageToken(),
 	}
 
 	// 2. Вызываем репозиторий
 	listRes, err := uc.repo.ListRatings(ctx, listReq)
 	if err != nil {
-		uc.log.Error("Usecase.ListRatings: ошибка получения оценок", zap.Error(err), zap.Int("movie_id", listReq.MovieID))
+		uc.logger(ctx).Error("Usecase.ListRatings: ошибка получения оценок", zap.Error(err), zap.Any("target", target))
 		return nil, err
 	}
 
 	// 3. Маппим Entity → Protobuf
 	ratingsProto := make([]*protos.Rating, 0, len(listRes.Ratings))
 	for _, r := range listRes.Ratings {
-		ratingsProto = append(ratingsProto, &protos.Rating{
-			Id:        int32(r.ID),
-			MovieId:   int32(r.MovieID),
-			UserId:    int32(r.UserID),
-			Score:     int32(r.Score),
-			CreatedAt: timestamppb.New(r.CreatedAt),
-			UpdatedAt: timestamppb.New(r.UpdatedAt),
-		})
+		ratingsProto = append(ratingsProto, ratingToProto(r))
 	}
 
 	// 4. Формируем и возвращаем ответ
 	resp := &protos.ListRatingsResponse{
-		Ratings: ratingsProto,
-		Total:   int32(listRes.Total),
+		Ratings:       ratingsProto,
+		Total:         int32(listRes.Total),
+		NextPageToken: listRes.NextPageToken,
 	}
-	uc.log.Info("Usecase.ListRatings: сформирован ответ",
+	uc.logger(ctx).Info("Usecase.ListRatings: сформирован ответ",
 		zap.Int("returned", len(ratingsProto)),
 		zap.Int("total", listRes.Total),
 	)
 	return resp, nil
 }
 
-// GetRating возвращает конкретную оценку по ID фильма и ID оценки.
+// GetRating возвращает конкретную оценку по ID фильма (сериала или эпизода) и ID оценки.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с идентификаторами фильма и оценки.
+//   - req: DTO с идентификаторами объекта и оценки.
 //
 // Возвращает:
 //   - Rating: DTO с деталями оценки.
 //   - error: ошибку, если оценка не найдена или сбой БД.
 func (uc *Usecase) GetRating(ctx context.Context, req *protos.GetRatingRequest) (*protos.Rating, error) {
-	uc.log.Info("Usecase.GetRating: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.GetRating: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("rating_id", req.GetRatingId()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.GetRating: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
 
 	// 1. Вызываем репозиторий для получения оценки
-	ratingEntity, err := uc.repo.GetRating(ctx, int(req.GetMovieId()), int(req.GetRatingId()))
+	ratingEntity, err := uc.repo.GetRating(ctx, target, int(req.GetRatingId()))
 	if err != nil {
-		uc.log.Error("Usecase.GetRating: ошибка получения оценки", zap.Error(err),
-			zap.Int("movie_id", int(req.GetMovieId())),
+		uc.logger(ctx).Error("Usecase.GetRating: ошибка получения оценки", zap.Error(err),
+			zap.Any("target", target),
 			zap.Int("rating_id", int(req.GetRatingId())),
 		)
 		return nil, err
 	}
 
 	// 2. Маппим Entity → Protobuf
-	ratingProto := &protos.Rating{
-		Id:        int32(ratingEntity.ID),
-		MovieId:   int32(ratingEntity.MovieID),
-		UserId:    int32(ratingEntity.UserID),
-		Score:     int32(ratingEntity.Score),
-		CreatedAt: timestamppb.New(ratingEntity.CreatedAt),
-		UpdatedAt: timestamppb.New(ratingEntity.UpdatedAt),
-	}
+	ratingProto := ratingToProto(ratingEntity)
 
-	uc.log.Info("Usecase.GetRating: сформирован ответ", zap.Int32("id", ratingProto.GetId()))
+	uc.logger(ctx).Info("Usecase.GetRating: сформирован ответ", zap.Int32("id", ratingProto.GetId()))
 	return ratingProto, nil
 }
 
-// CreateRating создаёт новую оценку для фильма.
+// CreateRating создаёт оценку фильма, сериала или эпизода или обновляет score, если пользователь
+// уже оценивал этот объект.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с данными для новой оценки (ID фильма, ID пользователя, значение от 1 до 10).
+//   - req: DTO с данными для новой оценки (ID объекта, значение от 1 до 10); автор берётся из контекста.
 //
 // Возвращает:
-//   - CreateRatingResponse: DTO с созданной оценкой.
-//   - error: ошибку валидации или записи в БД.
+//   - CreateRatingResponse: DTO с сохранённой оценкой и признаком created.
+//   - error: ошибку аутентификации, валидации или записи в БД.
 func (uc *Usecase) CreateRating(ctx context.Context, req *protos.CreateRatingRequest) (*protos.CreateRatingResponse, error) {
-	uc.log.Info("Usecase.CreateRating: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
-		zap.Int32("user_id", req.GetUserId()),
+	// автор оценки — всегда пользователь из токена, user_id из тела запроса игнорируется
+	userID, ok := JWT.UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.CreateRating: входной запрос",
+		zap.Any("target", target),
+		zap.Int32("user_id", userID),
 		zap.Int32("score", req.GetScore()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.CreateRating: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
+	if err := validateScore(int(req.GetScore())); err != nil {
+		return nil, err
+	}
 
 	// 1. Маппим Protobuf → Entity
 	ratingEntity := &entities.Rating{
-		MovieID: int(req.GetMovieId()),
-		UserID:  int(req.GetUserId()),
-		Score:   int(req.GetScore()),
+		MovieID:   target.MovieID,
+		SeriesID:  target.SeriesID,
+		EpisodeID: target.EpisodeID,
+		UserID:    int(userID),
+		Score:     int(req.GetScore()),
 	}
 
-	// 2. Вызываем репозиторий для создания оценки
-	created, err := uc.repo.CreateRating(ctx, ratingEntity)
+	// 2. Вызываем репозиторий: новая оценка создаётся, существующая — обновляется
+	saved, inserted, err := uc.repo.CreateRating(ctx, ratingEntity)
 	if err != nil {
-		uc.log.Error("Usecase.CreateRating: ошибка создания оценки", zap.Error(err))
+		uc.logger(ctx).Error("Usecase.CreateRating: ошибка сохранения оценки", zap.Error(err))
 		return nil, err
 	}
 
 	// 3. Маппим Entity → Protobuf
-	ratingProto := &protos.Rating{
-		Id:        int32(created.ID),
-		MovieId:   int32(created.MovieID),
-		UserId:    int32(created.UserID),
-		Score:     int32(created.Score),
-		CreatedAt: timestamppb.New(created.CreatedAt),
-		UpdatedAt: timestamppb.New(created.UpdatedAt),
+	ratingProto := ratingToProto(saved)
+
+	uc.logger(ctx).Info("Usecase.CreateRating: оценка успешно сохранена",
+		zap.Int32("id", ratingProto.GetId()),
+		zap.Bool("created", inserted),
+	)
+	return &protos.CreateRatingResponse{Rating: ratingProto, Created: inserted}, nil
+}
+
+// GetMyRating возвращает оценку фильма, сериала или эпизода, поставленную текущим пользователем.
+//
+// Параметры:
+//   - ctx: контекст выполнения с пользователем из токена.
+//   - req: DTO с ID фильма, сериала или эпизода.
+//
+// Возвращает:
+//   - Rating: DTO с оценкой пользователя.
+//   - error: ошибку аутентификации, если пользователь не оценивал объект, или сбой БД.
+func (uc *Usecase) GetMyRating(ctx context.Context, req *protos.GetMyRatingRequest) (*protos.Rating, error) {
+	userID, ok := JWT.UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.GetMyRating: входной запрос",
+		zap.Any("target", target),
+		zap.Int32("user_id", userID),
+	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.GetMyRating: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
+
+	ratingEntity, err := uc.repo.GetRatingByUser(ctx, target, int(userID))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.GetMyRating: ошибка получения оценки", zap.Error(err),
+			zap.Any("target", target),
+			zap.Int32("user_id", userID),
+		)
+		return nil, err
 	}
 
-	uc.log.Info("Usecase.CreateRating: оценка успешно создана", zap.Int32("id", ratingProto.GetId()))
-	return &protos.CreateRatingResponse{Rating: ratingProto}, nil
+	return ratingToProto(ratingEntity), nil
 }
 
-// DeleteRating удаляет оценку по ID фильма и ID оценки.
+// DeleteRating удаляет оценку по ID фильма (сериала или эпизода) и ID оценки. Удалить оценку может только её автор.
 //
 // Параметры:
-//   - ctx: контекст выполнения.
-//   - req: DTO с идентификаторами фильма и оценки.
+//   - ctx: контекст выполнения с пользователем из токена.
+//   - req: DTO с идентификаторами объекта и оценки.
 //
 // Возвращает:
 //   - Empty: пустой ответ при успешном удалении.
-//   - error: ошибку, если оценка не найдена или сбой БД.
+//   - error: ошибку, если оценка не найдена, принадлежит другому пользователю или сбой БД.
 func (uc *Usecase) DeleteRating(ctx context.Context, req *protos.DeleteRatingRequest) (*emptypb.Empty, error) {
-	uc.log.Info("Usecase.DeleteRating: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.DeleteRating: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("rating_id", req.GetRatingId()),
 	)
 
-	// 1. Маппим Protobuf → Entity
-	ratingEntity := &entities.Rating{
-		ID:      int(req.GetRatingId()),
-		MovieID: int(req.GetMovieId()),
+	// 1. Удалять оценку может только её автор
+	ratingEntity, err := uc.repo.GetRating(ctx, target, int(req.GetRatingId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.DeleteRating: ошибка получения оценки", zap.Error(err), zap.Int32("rating_id", req.GetRatingId()))
+		return nil, err
+	}
+	if err := uc.checkAuthor(ctx, ratingEntity.UserID); err != nil {
+		uc.logger(ctx).Warn("Usecase.DeleteRating: удаление чужой оценки", zap.Error(err), zap.Int("rating_id", ratingEntity.ID))
+		return nil, err
 	}
 
 	// 2. Вызываем репозиторий для удаления оценки
 	if err := uc.repo.DeleteRating(ctx, ratingEntity); err != nil {
-		uc.log.Error("Usecase.DeleteRating: ошибка удаления оценки", zap.Error(err), zap.Int("rating_id", ratingEntity.ID))
+		uc.logger(ctx).Error("Usecase.DeleteRating: ошибка удаления оценки", zap.Error(err), zap.Int("rating_id", ratingEntity.ID))
 		return nil, err
 	}
 
-	uc.log.Info("Usecase.DeleteRating: оценка успешно удалена", zap.Int("rating_id", ratingEntity.ID))
+	uc.logger(ctx).Info("Usecase.DeleteRating: оценка успешно удалена", zap.Int("rating_id", ratingEntity.ID))
 	return &emptypb.Empty{}, nil
 }
 
-// ListComments возвращает постраничный список комментариев к фильму.
+// ListComments возвращает постраничный список комментариев к фильму, сериалу или эпизоду: корневые
+// комментарии или прямые ответы на parent_id, при with_replies — вместе с ветками ответов.
+// Комментарии неопубликованного фильма, как и сам фильм, видны только администраторам.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с ID фильма и параметрами пагинации.
+//   - req: DTO с ID объекта, уровнем ветки и параметрами пагинации.
 //
 // Возвращает:
 //   - ListCommentsResponse: DTO со списком комментариев и общим количеством.
-//   - error: ошибку выполнения.
+//   - error: ошибку, если родительский комментарий не найден, или ошибку выполнения.
 func (uc *Usecase) ListComments(ctx context.Context, req *protos.ListCommentsRequest) (*protos.ListCommentsResponse, error) {
-	uc.log.Info("Usecase.ListComments: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.ListComments: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("page", req.GetPage()),
 		zap.Int32("per_page", req.GetPerPage()),
+		zap.Int32("parent_id", req.GetParentId()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.ListComments: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
 
-	// 1. Маппим Protobuf → Entity
+	// 1. Ответы на несуществующий комментарий — 404, а не пустая страница
+	if req.GetParentId() != 0 {
+		if _, err := uc.repo.GetComment(ctx, target, int(req.GetParentId())); err != nil {
+			uc.logger(ctx).Error("Usecase.ListComments: ошибка получения родительского комментария", zap.Error(err),
+				zap.Int32("parent_id", req.GetParentId()),
+			)
+			return nil, err
+		}
+	}
+
+	// 2. Маппим Protobuf → Entity
 	listReq := &entities.ListCommentsRequest{
-		MovieID: int(req.GetMovieId()),
-		Page:    int(req.GetPage()),
-		PerPage: int(req.GetPerPage()),
+		Target:      target,
+		Page:        int(req.GetPage()),
+		PerPage:     int(req.GetPerPage()),
+		PageToken:   req.GetPageToken(),
+		ParentID:    int(req.GetParentId()),
+		WithReplies: req.GetWithReplies(),
 	}
 
-	// 2. Вызываем репозиторий
+	// 3. Вызываем репозиторий
 	listRes, err := uc.repo.ListComments(ctx, listReq)
 	if err != nil {
-		uc.log.Error("Usecase.ListComments: ошибка получения комментариев", zap.Error(err),
-			zap.Int("movie_id", listReq.MovieID),
+		uc.logger(ctx).Error("Usecase.ListComments: ошибка получения комментариев", zap.Error(err),
+			zap.Any("target", target),
 		)
 		return nil, err
 	}
 
-	// 3. Маппим Entity → Protobuf
+	// 4. Маппим Entity → Protobuf
 	commentsProto := make([]*protos.Comment, 0, len(listRes.Comments))
 	for _, c := range listRes.Comments {
-		commentsProto = append(commentsProto, &protos.Comment{
-			Id:        int32(c.ID),
-			MovieId:   int32(c.MovieID),
-			UserId:    int32(c.UserID),
-			Text:      c.Text,
-			CreatedAt: timestamppb.New(c.CreatedAt),
-			UpdatedAt: timestamppb.New(c.UpdatedAt),
-		})
+		commentsProto = append(commentsProto, commentToProto(c))
 	}
 
-	// 4. Формируем и возвращаем ответ
+	// 5. Формируем и возвращаем ответ
 	resp := &protos.ListCommentsResponse{
-		Comments: commentsProto,
-		Total:    int32(listRes.Total),
+		Comments:      commentsProto,
+		Total:         int32(listRes.Total),
+		NextPageToken: listRes.NextPageToken,
 	}
-	uc.log.Info("Usecase.ListComments: сформирован ответ",
+	uc.logger(ctx).Info("Usecase.ListComments: сформирован ответ",
 		zap.Int("returned", len(commentsProto)),
 		zap.Int("total", listRes.Total),
 	)
 	return resp, nil
 }
 
-// GetComment возвращает конкретный комментарий по ID фильма и ID комментария.
+// GetComment возвращает конкретный комментарий по ID фильма (сериала или эпизода) и ID комментария.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с идентификаторами фильма и комментария.
+//   - req: DTO с идентификаторами объекта и комментария.
 //
 // Возвращает:
 //   - Comment: DTO с текстом и метаданными комментария.
 //   - error: ошибку, если комментарий не найден или сбой БД.
 func (uc *Usecase) GetComment(ctx context.Context, req *protos.GetCommentRequest) (*protos.Comment, error) {
-	uc.log.Info("Usecase.GetComment: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.GetComment: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("comment_id", req.GetCommentId()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.GetComment: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
 
 	// 1. Вызываем репозиторий
-	commentEntity, err := uc.repo.GetComment(ctx, int(req.GetMovieId()), int(req.GetCommentId()))
+	commentEntity, err := uc.repo.GetComment(ctx, target, int(req.GetCommentId()))
 	if err != nil {
-		uc.log.Error("Usecase.GetComment: ошибка получения комментария", zap.Error(err),
-			zap.Int("movie_id", int(req.GetMovieId())),
+		uc.logger(ctx).Error("Usecase.GetComment: ошибка получения комментария", zap.Error(err),
+			zap.Any("target", target),
 			zap.Int("comment_id", int(req.GetCommentId())),
 		)
 		return nil, err
 	}
 
 	// 2. Маппим Entity → Protobuf
-	commentProto := &protos.Comment{
-		Id:        int32(commentEntity.ID),
-		MovieId:   int32(commentEntity.MovieID),
-		UserId:    int32(commentEntity.UserID),
-		Text:      commentEntity.Text,
-		CreatedAt: timestamppb.New(commentEntity.CreatedAt),
-		UpdatedAt: timestamppb.New(commentEntity.UpdatedAt),
-	}
+	commentProto := commentToProto(commentEntity)
 
-	uc.log.Info("Usecase.GetComment: сформирован ответ", zap.Int32("id", commentProto.GetId()))
+	uc.logger(ctx).Info("Usecase.GetComment: сформирован ответ", zap.Int32("id", commentProto.GetId()))
 	return commentProto, nil
 }
 
-// CreateComment создаёт новый комментарий к фильму.
+// CreateComment создаёт новый комментарий к фильму, сериалу или эпизоду или ответ на комментарий parent_id.
 //
 // Параметры:
 //   - ctx: контекст выполнения.
-//   - req: DTO с данными для нового комментария (ID фильма, ID пользователя, текст).
+//   - req: DTO с данными для нового комментария (ID объекта, текст, родитель); автор берётся из контекста.
 //
 // Возвращает:
 //   - CreateCommentResponse: DTO с созданным комментарием.
-//   - error: ошибку валидации или записи в БД.
+//   - error: ошибку аутентификации, валидации (в т.ч. превышение глубины ветки) или записи в БД.
 func (uc *Usecase) CreateComment(ctx context.Context, req *protos.CreateCommentRequest) (*protos.CreateCommentResponse, error) {
-	uc.log.Info("Usecase.CreateComment: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
-		zap.Int32("user_id", req.GetUserId()),
+	// автор комментария — всегда пользователь из токена, user_id из тела запроса игнорируется
+	userID, ok := JWT.UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.CreateComment: входной запрос",
+		zap.Any("target", target),
+		zap.Int32("user_id", userID),
+		zap.Int32("parent_id", req.GetParentId()),
 	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.CreateComment: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
+	if err := uc.validateCommentText(req.GetText()); err != nil {
+		return nil, err
+	}
 
 	// 1. Маппим Protobuf → Entity
 	commentEntity := &entities.Comment{
-		MovieID: int(req.GetMovieId()),
-		UserID:  int(req.GetUserId()),
-		Text:    req.GetText(),
+		MovieID:   target.MovieID,
+		SeriesID:  target.SeriesID,
+		EpisodeID: target.EpisodeID,
+		UserID:    int(userID),
+		Text:      req.GetText(),
 	}
 
-	// 2. Вызываем репозиторий для создания
+	// 2. Ответ должен ссылаться на комментарий того же объекта и не превышать глубину ветки
+	if req.GetParentId() != 0 {
+		parent, err := uc.repo.GetComment(ctx, target, int(req.GetParentId()))
+		if err != nil {
+			uc.logger(ctx).Error("Usecase.CreateComment: ошибка получения родительского комментария", zap.Error(err),
+				zap.Int32("parent_id", req.GetParentId()),
+			)
+			return nil, err
+		}
+		if err := uc.validateCommentParent(target, parent); err != nil {
+			return nil, err
+		}
+		commentEntity.ParentID = parent.ID
+		commentEntity.Depth = parent.Depth + 1
+	}
+
+	// 3. Вызываем репозиторий для создания
 	created, err := uc.repo.CreateComment(ctx, commentEntity)
 	if err != nil {
-		uc.log.Error("Usecase.CreateComment: ошибка создания комментария", zap.Error(err))
+		uc.logger(ctx).Error("Usecase.CreateComment: ошибка создания комментария", zap.Error(err))
 		return nil, err
 	}
 
-	// 3. Маппим Entity → Protobuf
-	commentProto := &protos.Comment{
-		Id:        int32(created.ID),
-		MovieId:   int32(created.MovieID),
-		UserId:    int32(created.UserID),
-		Text:      created.Text,
-		CreatedAt: timestamppb.New(created.CreatedAt),
-		UpdatedAt: timestamppb.New(created.UpdatedAt),
-	}
+	// 4. Маппим Entity → Protobuf
+	commentProto := commentToProto(created)
 
-	uc.log.Info("Usecase.CreateComment: комментарий успешно создан", zap.Int32("id", commentProto.GetId()))
+	uc.logger(ctx).Info("Usecase.CreateComment: комментарий успешно создан", zap.Int32("id", commentProto.GetId()))
 	return &protos.CreateCommentResponse{Comment: commentProto}, nil
 }
 
-// DeleteComment удаляет комментарий по ID фильма и ID комментария.
+// EditComment заменяет текст комментария и помечает его как отредактированный.
+// Редактировать комментарий может только его автор.
 //
 // Параметры:
-//   - ctx: контекст выполнения.
-//   - req: DTO с идентификаторами фильма и комментария.
+//   - ctx: контекст выполнения с пользователем из токена.
+//   - req: DTO с идентификаторами объекта и комментария и новым текстом.
+//
+// Возвращает:
+//   - Comment: DTO с обновлённым комментарием.
+//   - error: ошибку, если комментарий не найден, принадлежит другому пользователю, текст невалиден или сбой БД.
+func (uc *Usecase) EditComment(ctx context.Context, req *protos.EditCommentRequest) (*protos.Comment, error) {
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.EditComment: входной запрос",
+		zap.Any("target", target),
+		zap.Int32("comment_id", req.GetCommentId()),
+	)
+	if err := uc.checkTargetVisible(ctx, target); err != nil {
+		uc.logger(ctx).Info("Usecase.EditComment: объект недоступен", zap.Error(err), zap.Any("target", target))
+		return nil, err
+	}
+	if err := uc.validateCommentText(req.GetText()); err != nil {
+		return nil, err
+	}
+
+	// 1. Редактировать комментарий может только автор, модераторы чужой текст не меняют
+	commentEntity, err := uc.repo.GetComment(ctx, target, int(req.GetCommentId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.EditComment: ошибка получения комментария", zap.Error(err), zap.Int32("comment_id", req.GetCommentId()))
+		return nil, err
+	}
+	if err := uc.checkAuthor(ctx, commentEntity.UserID); err != nil {
+		uc.logger(ctx).Warn("Usecase.EditComment: редактирование чужого комментария", zap.Error(err), zap.Int("comment_id", commentEntity.ID))
+		return nil, err
+	}
+
+	// 2. Вызываем репозиторий для обновления
+	commentEntity.Text = req.GetText()
+	updated, err := uc.repo.UpdateComment(ctx, commentEntity)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.EditComment: ошибка обновления комментария", zap.Error(err),
+			zap.Int("comment_id", commentEntity.ID),
+		)
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.EditComment: комментарий успешно обновлён", zap.Int("comment_id", updated.ID))
+	return commentToProto(updated), nil
+}
+
+// DeleteComment удаляет комментарий по ID фильма (сериала или эпизода) и ID комментария вместе со всеми ответами на него.
+// Удалить комментарий может его автор, а чужой — модератор или администратор.
+//
+// Параметры:
+//   - ctx: контекст выполнения с пользователем из токена.
+//   - req: DTO с идентификаторами объекта и комментария.
 //
 // Возвращает:
 //   - Empty: пустой ответ при успешном удалении.
-//   - error: ошибку, если комментарий не найден или сбой БД.
+//   - error: ошибку, если комментарий не найден, принадлежит другому пользователю или сбой БД.
 func (uc *Usecase) DeleteComment(ctx context.Context, req *protos.DeleteCommentRequest) (*emptypb.Empty, error) {
-	uc.log.Info("Usecase.DeleteComment: входной запрос",
-		zap.Int32("movie_id", req.GetMovieId()),
+	target, err := targetOf(req.GetMovieId(), req.GetSeriesId(), req.GetEpisodeId())
+	if err != nil {
+		return nil, err
+	}
+	uc.logger(ctx).Info("Usecase.DeleteComment: входной запрос",
+		zap.Any("target", target),
 		zap.Int32("comment_id", req.GetCommentId()),
 	)
 
-	// 1. Маппим Protobuf → Entity
-	commentEntity := &entities.Comment{
-		ID:      int(req.GetCommentId()),
-		MovieID: int(req.GetMovieId()),
+	// 1. Удалять комментарий может его автор или модератор
+	commentEntity, err := uc.repo.GetComment(ctx, target, int(req.GetCommentId()))
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.DeleteComment: ошибка получения комментария", zap.Error(err), zap.Int32("comment_id", req.GetCommentId()))
+		return nil, err
+	}
+	if err := uc.checkAuthor(ctx, commentEntity.UserID, JWT.RoleModerator); err != nil {
+		uc.logger(ctx).Warn("Usecase.DeleteComment: удаление чужого комментария", zap.Error(err), zap.Int("comment_id", commentEntity.ID))
+		return nil, err
 	}
 
 	// 2. Вызываем репозиторий для удаления
 	if err := uc.repo.DeleteComment(ctx, commentEntity); err != nil {
-		uc.log.Error("Usecase.DeleteComment: ошибка удаления комментария", zap.Error(err),
+		uc.logger(ctx).Error("Usecase.DeleteComment: ошибка удаления комментария", zap.Error(err),
 			zap.Int("comment_id", commentEntity.ID),
 		)
 		return nil, err
 	}
 
-	uc.log.Info("Usecase.DeleteComment: комментарий успешно удалён", zap.Int("comment_id", commentEntity.ID))
+	uc.logger(ctx).Info("Usecase.DeleteComment: комментарий успешно удалён", zap.Int("comment_id", commentEntity.ID))
 	return &emptypb.Empty{}, nil
 }
+
+// ListGenres возвращает все жанры с количеством фильмов в каждом.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: пустой DTO запроса.
+//
+// Возвращает:
+//   - ListGenresResponse: DTO со списком жанров и их количеством.
+//   - error: ошибку выполнения.
+func (uc *Usecase) ListGenres(ctx context.Context, _ *protos.ListGenresRequest) (*protos.ListGenresResponse, error) {
+	uc.logger(ctx).Info("Usecase.ListGenres: входной запрос")
+
+	// 1. Вызываем репозиторий
+	listRes, err := uc.repo.ListGenres(ctx)
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.ListGenres: ошибка получения жанров", zap.Error(err))
+		return nil, err
+	}
+
+	// 2. Маппим Entity → Protobuf
+	genresProto := make([]*protos.Genre, 0, len(listRes.Genres))
+	for _, g := range listRes.Genres {
+		genresProto = append(genresProto, genreToProto(g))
+	}
+
+	uc.logger(ctx).Info("Usecase.ListGenres: сформирован ответ", zap.Int("total", listRes.Total))
+	return &protos.ListGenresResponse{
+		Genres: genresProto,
+		Total:  int32(listRes.Total),
+	}, nil
+}
+
+// CreateGenre создаёт новый жанр.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с названием жанра.
+//
+// Возвращает:
+//   - CreateGenreResponse: DTO с созданным жанром.
+//   - error: ошибку валидации названия, ошибку, если жанр с таким названием уже есть, или сбой БД.
+func (uc *Usecase) CreateGenre(ctx context.Context, req *protos.CreateGenreRequest) (*protos.CreateGenreResponse, error) {
+	uc.logger(ctx).Info("Usecase.CreateGenre: входной запрос", zap.String("name", req.GetName()))
+	if err := uc.validateGenreName(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.CreateGenre(ctx, &entities.Genre{Name: req.GetName()})
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.CreateGenre: ошибка создания жанра", zap.Error(err))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.CreateGenre: жанр успешно создан", zap.Int("id", created.ID))
+	return &protos.CreateGenreResponse{Genre: genreToProto(created)}, nil
+}
+
+// UpdateGenre переименовывает жанр.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID жанра и новым названием.
+//
+// Возвращает:
+//   - UpdateGenreResponse: DTO с обновлённым жанром.
+//   - error: ошибку, если жанр не найден, название занято или сбой БД.
+func (uc *Usecase) UpdateGenre(ctx context.Context, req *protos.UpdateGenreRequest) (*protos.UpdateGenreResponse, error) {
+	uc.logger(ctx).Info("Usecase.UpdateGenre: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.String("name", req.GetName()),
+	)
+	if err := uc.validateGenreName(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.UpdateGenre(ctx, &entities.Genre{
+		ID:   int(req.GetId()),
+		Name: req.GetName(),
+	})
+	if err != nil {
+		uc.logger(ctx).Error("Usecase.UpdateGenre: ошибка переименования жанра", zap.Error(err), zap.Int32("id", req.GetId()))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.UpdateGenre: жанр успешно переименован", zap.Int("id", updated.ID))
+	return &protos.UpdateGenreResponse{Genre: genreToProto(updated)}, nil
+}
+
+// DeleteGenre удаляет жанр.
+//
+// Параметры:
+//   - ctx: контекст выполнения.
+//   - req: DTO с ID жанра и флагом force для удаления вместе с привязками к фильмам.
+//
+// Возвращает:
+//   - Empty: пустой ответ при успешном удалении.
+//   - error: ошибку, если жанр не найден, используется фильмами (без force) или сбой БД.
+func (uc *Usecase) DeleteGenre(ctx context.Context, req *protos.DeleteGenreRequest) (*emptypb.Empty, error) {
+	uc.logger(ctx).Info("Usecase.DeleteGenre: входной запрос",
+		zap.Int32("id", req.GetId()),
+		zap.Bool("force", req.GetForce()),
+	)
+
+	genreEntity := &entities.Genre{ID: int(req.GetId())}
+	if err := uc.repo.DeleteGenre(ctx, genreEntity, req.GetForce()); err != nil {
+		uc.logger(ctx).Error("Usecase.DeleteGenre: ошибка удаления жанра", zap.Error(err), zap.Int("id", genreEntity.ID))
+		return nil, err
+	}
+
+	uc.logger(ctx).Info("Usecase.DeleteGenre: жанр успешно удалён", zap.Int("id", genreEntity.ID))
+	return &emptypb.Empty{}, nil
+}
+
+// checkAuthor проверяет, что пользователь из контекста — автор записи с authorID
+// либо обладает одной из overrideRoles, позволяющих менять чужие записи.
+func (uc *Usecase) checkAuthor(ctx context.Context, authorID int, overrideRoles ...string) error {
+	claims, ok := JWT.ClaimsFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if int(claims.UserID) == authorID {
+		return nil
+	}
+	if len(overrideRoles) > 0 && JWT.HasRole(claims.Role, overrideRoles...) {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// dateOrZero переводит дату из запроса; не заданная дата остаётся нулевой, чтобы её отклонила валидация.
+// AsTime для nil вернул бы 1970-01-01, неотличимое от настоящей даты.
+func dateOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// parseSortOrder переводит sort_order (asc|desc, без учёта регистра) в признак обратной сортировки.
+func parseSortOrder(order string) (bool, error) {
+	switch strings.ToLower(order) {
+	case "", "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: unknown sort_order %q", ErrInvalidSort, order)
+	}
+}
+
+// ratingToProto маппит сущность оценки в Protobuf.
+func ratingToProto(r *entities.Rating) *protos.Rating {
+	return &protos.Rating{
+		Id:        int32(r.ID),
+		MovieId:   int32(r.MovieID),
+		SeriesId:  int32(r.SeriesID),
+		EpisodeId: int32(r.EpisodeID),
+		UserId:    int32(r.UserID),
+		Score:     int32(r.Score),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+// commentToProto маппит сущность комментария в Protobuf вместе с веткой ответов.
+func commentToProto(c *entities.Comment) *protos.Comment {
+	replies := make([]*protos.Comment, 0, len(c.Replies))
+	for _, reply := range c.Replies {
+		replies = append(replies, commentToProto(reply))
+	}
+	return &protos.Comment{
+		Id:         int32(c.ID),
+		MovieId:    int32(c.MovieID),
+		SeriesId:   int32(c.SeriesID),
+		EpisodeId:  int32(c.EpisodeID),
+		UserId:     int32(c.UserID),
+		Text:       c.Text,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+		ParentId:   int32(c.ParentID),
+		Depth:      int32(c.Depth),
+		Edited:     c.Edited,
+		ReplyCount: int32(c.ReplyCount),
+		Replies:    replies,
+	}
+}
+
+// genreToProto маппит сущность жанра в Protobuf.
+func genreToProto(g *entities.Genre) *protos.Genre {
+	return &protos.Genre{
+		Id:         int32(g.ID),
+		Name:       g.Name,
+		MovieCount: int32(g.MovieCount),
+	}
+}
+
+// movieToProto маппит сущность фильма в Protobuf вместе с жанрами, титрами и агрегатами оценок.
+func movieToProto(m *entities.Movie) *protos.Movie {
+	protoGenres := make([]*protos.Genre, 0, len(m.Genres))
+	for _, g := range m.Genres {
+		protoGenres = append(protoGenres, &protos.Genre{
+			Id:   int32(g.ID),
+			Name: g.Name,
+		})
+	}
+	protoCredits := make([]*protos.Credit, 0, len(m.Credits))
+	for _, c := range m.Credits {
+		protoCredits = append(protoCredits, creditToProto(c))
+	}
+	protoCertifications := make([]*protos.Certification, 0, len(m.Certifications))
+	for _, c := range m.Certifications {
+		protoCertifications = append(protoCertifications, certificationToProto(c))
+	}
+
+	return &protos.Movie{
+		Id:          int32(m.ID),
+		Title:       m.Title,
+		VideoUrl:    m.VideoURL,
+		CoverUrl:    m.CoverURL,
+		Description: m.Description,
+		ReleaseDate: timestamppb.New(m.ReleaseDate),
+		DurationMin: int32(m.DurationMin),
+		Genres:      protoGenres,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		RatingStats: ratingStatsToProto(m.RatingStats),
+		Credits:     protoCredits,
+		Locale:      m.Locale,
+		Status:      m.Status,
+		PublishAt:   timestampOrNil(m.PublishAt),
+		UnpublishAt: timestampOrNil(m.UnpublishAt),
+
+		Certifications:     protoCertifications,
+		ContentDescriptors: m.ContentDescriptors,
+	}
+}
+
+// ratingStatsToProto маппит агрегаты оценок; гистограмма всегда содержит 10 элементов (score 1..10).
+func ratingStatsToProto(st entities.RatingStats) *protos.RatingStats {
+	histogram := make([]int32, 10)
+	for i, n := range st.Histogram {
+		if i < len(histogram) {
+			histogram[i] = int32(n)
+		}
+	}
+	return &protos.RatingStats{
+		Average:   st.Average,
+		Votes:     int32(st.Votes),
+		Histogram: histogram,
+	}
+}
@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/locale"
+)
+
+// Границы оценки фильма; совпадают с CHECK (score BETWEEN 1 AND 10) в таблице ratings.
+const (
+	minScore = 1
+	maxScore = 10
+)
+
+// violations собирает нарушения по полям запроса; пустой список — запрос валиден.
+type violations []errs.Violation
+
+func (v *violations) add(field, rule, format string, args ...any) {
+	*v = append(*v, errs.Violation{Field: field, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// err возвращает ошибку валидации со всеми нарушениями или nil.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return errs.Validation(v)
+}
+
+// text проверяет обязательность и длину строки (в символах); max = 0 — без ограничения.
+func (v *violations) text(field, value string, required bool, max int) {
+	if required && strings.TrimSpace(value) == "" {
+		v.add(field, "required", "%s is required", field)
+		return
+	}
+	if max > 0 && utf8.RuneCountInString(value) > max {
+		v.add(field, "max_length", "%s must be at most %d characters", field, max)
+	}
+}
+
+// url проверяет, что значение — абсолютный http(s)-URL.
+func (v *violations) url(field, value string) {
+	if value == "" {
+		v.add(field, "required", "%s is required", field)
+		return
+	}
+	u, err := url.ParseRequestURI(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(field, "url", "%s must be an absolute http(s) URL", field)
+	}
+}
+
+// validateMovie проверяет поля фильма. Проверяются только заданные (не nil) поля DTO,
+// поэтому функция подходит и для создания/замены, и для частичного обновления.
+func (uc *Usecase) validateMovie(ctx context.Context, movie *entities.MovieDTO) error {
+	limits := uc.cfg.Validation
+	var v violations
+
+	if movie.Title != nil {
+		v.text("title", *movie.Title, true, limits.TitleMaxLength)
+	}
+	if movie.Description != nil {
+		v.text("description", *movie.Description, false, limits.DescriptionMaxLength)
+	}
+	if movie.VideoURL != nil {
+		v.url("video_url", *movie.VideoURL)
+	}
+	if movie.CoverURL != nil {
+		v.url("cover_url", *movie.CoverURL)
+	}
+	if movie.ReleaseDate != nil && movie.ReleaseDate.IsZero() {
+		v.add("release_date", "required", "release_date is required")
+	}
+	if movie.DurationMin != nil {
+		switch d := *movie.DurationMin; {
+		case d <= 0:
+			v.add("duration_min", "min", "duration_min must be positive")
+		case limits.DurationMaxMin > 0 && d > limits.DurationMaxMin:
+			v.add("duration_min", "max", "duration_min must be at most %d", limits.DurationMaxMin)
+		}
+	}
+	if movie.GenreIDs != nil {
+		if err := uc.validateGenreIDs(ctx, movie.GenreIDs, &v); err != nil {
+			return err
+		}
+	}
+	return v.err()
+}
+
+// validateGenreIDs проверяет количество, уникальность и существование жанров.
+func (uc *Usecase) validateGenreIDs(ctx context.Context, genreIDs []int, v *violations) error {
+	if max := uc.cfg.Validation.MaxGenres; max > 0 && len(genreIDs) > max {
+		v.add("genre_ids", "max_items", "at most %d genres are allowed", max)
+	}
+	if len(genreIDs) == 0 {
+		return nil
+	}
+
+	genres, err := uc.repo.ListGenres(ctx)
+	if err != nil {
+		return err
+	}
+	known := make(map[int]bool, len(genres.Genres))
+	for _, g := range genres.Genres {
+		known[g.ID] = true
+	}
+	seen := make(map[int]bool, len(genreIDs))
+	for i, id := range genreIDs {
+		field := fmt.Sprintf("genre_ids[%d]", i)
+		switch {
+		case seen[id]:
+			v.add(field, "unique", "genre %d is listed more than once", id)
+		case !known[id]:
+			v.add(field, "exists", "genre %d does not exist", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// validateMovieTranslation проверяет перевод фильма. Перевод на локаль по умолчанию не нужен:
+// этот текст хранится в самом фильме и меняется через UpdateMovie.
+func (uc *Usecase) validateMovieTranslation(t *entities.MovieTranslation) error {
+	limits := uc.cfg.Validation
+	var v violations
+	switch tag, ok := locale.Normalize(t.Locale); {
+	case !ok:
+		v.add("locale", "format", "locale must be a language tag such as en or pt-br")
+	case tag == uc.defaultLocale():
+		v.add("locale", "default", "%s is the default locale, update the movie itself", tag)
+	}
+	v.text("title", t.Title, true, limits.TitleMaxLength)
+	v.text("description", t.Description, false, limits.DescriptionMaxLength)
+	return v.err()
+}
+
+// validateLocale проверяет языковой тег из запроса и возвращает его в нормализованном виде.
+func validateLocale(tag string) (string, error) {
+	var v violations
+	tag, ok := locale.Normalize(tag)
+	if !ok {
+		v.add("locale", "format", "locale must be a language tag such as en or pt-br")
+	}
+	return tag, v.err()
+}
+
+// validateSchedule проверяет расписание публикации на момент now: запланировать можно только
+// на будущее, а снять с публикации — только позже, чем фильм будет опубликован.
+func validateSchedule(now time.Time, movie *entities.Movie) error {
+	var v violations
+	if movie.Status == entities.MovieStatusScheduled {
+		switch {
+		case movie.PublishAt == nil:
+			v.add("publish_at", "required", "publish_at is required")
+		case !movie.PublishAt.After(now):
+			v.add("publish_at", "future", "publish_at must be in the future")
+		}
+	}
+	if movie.UnpublishAt != nil {
+		publishAt := now
+		if movie.PublishAt != nil {
+			publishAt = *movie.PublishAt
+		}
+		if !movie.UnpublishAt.After(publishAt) {
+			v.add("unpublish_at", "after_publish", "unpublish_at must be after the publication time")
+		}
+	}
+	return v.err()
+}
+
+// countryCodeRe — код страны ISO 3166-1 alpha-2 в верхнем регистре, как в CHECK на movie_certifications.country.
+var countryCodeRe = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// validateCertifications проверяет рейтинги фильма и описания содержимого: не больше одного
+// рейтинга на страну и только известные описания без повторов.
+func validateCertifications(certifications []entities.Certification, descriptors []string) error {
+	var v violations
+	countries := make(map[string]bool, len(certifications))
+	for i, c := range certifications {
+		field := fmt.Sprintf("certifications[%d]", i)
+		if !countryCodeRe.MatchString(c.Country) {
+			v.add(field+".country", "format", "country must be an ISO 3166-1 alpha-2 code such as US")
+		} else if countries[c.Country] {
+			v.add(field+".country", "unique", "country %s is listed more than once", c.Country)
+		}
+		countries[c.Country] = true
+		v.text(field+".certification", c.Certification, true, 16)
+		if c.MinAge < 0 || c.MinAge > entities.MaxCertificationAge {
+			v.add(field+".min_age", "range", "min_age must be between 0 and %d", entities.MaxCertificationAge)
+		}
+	}
+
+	seen := make(map[string]bool, len(descriptors))
+	for i, d := range descriptors {
+		field := fmt.Sprintf("content_descriptors[%d]", i)
+		if !slices.Contains(entities.ContentDescriptors, d) {
+			v.add(field, "enum", "content descriptor must be one of %s", strings.Join(entities.ContentDescriptors, ", "))
+		} else if seen[d] {
+			v.add(field, "unique", "content descriptor %s is listed more than once", d)
+		}
+		seen[d] = true
+	}
+	return v.err()
+}
+
+// validateAgeFilter проверяет параметры возрастного фильтра из запроса.
+func validateAgeFilter(f entities.AgeFilter) error {
+	var v violations
+	if f.Country != "" && !countryCodeRe.MatchString(f.Country) {
+		v.add("country", "format", "country must be an ISO 3166-1 alpha-2 code such as US")
+	}
+	if f.MaxAge != nil && (*f.MaxAge < 0 || *f.MaxAge > entities.MaxCertificationAge) {
+		v.add("max_age", "range", "max_age must be between 0 and %d", entities.MaxCertificationAge)
+	}
+	for i, c := range f.Certifications {
+		v.text(fmt.Sprintf("certifications[%d]", i), c, true, 16)
+	}
+	return v.err()
+}
+
+// validateScore проверяет оценку фильма.
+func validateScore(score int) error {
+	var v violations
+	if score < minScore || score > maxScore {
+		v.add("score", "range", "score must be between %d and %d", minScore, maxScore)
+	}
+	return v.err()
+}
+
+// targetOf собирает объект оценки или комментария из запроса. Без series_id и episode_id
+// объектом считается фильм movie_id.
+func targetOf(movieID, seriesID, episodeID int32) (entities.Target, error) {
+	set := 0
+	for _, id := range []int32{movieID, seriesID, episodeID} {
+		if id != 0 {
+			set++
+		}
+	}
+	if set > 1 {
+		return entities.Target{}, ErrAmbiguousTarget
+	}
+	return entities.Target{MovieID: int(movieID), SeriesID: int(seriesID), EpisodeID: int(episodeID)}, nil
+}
+
+// validateCommentText проверяет текст комментария.
+func (uc *Usecase) validateCommentText(text string) error {
+	var v violations
+	v.text("text", text, true, uc.cfg.Validation.CommentMaxLength)
+	return v.err()
+}
+
+// validateCommentParent проверяет, что parent относится к тому же объекту target, что и ответ,
+// и что ответ не превысит допустимую глубину ветки.
+func (uc *Usecase) validateCommentParent(target entities.Target, parent *entities.Comment) error {
+	var v violations
+	if parent.Target() != target {
+		v.add("parent_id", "same_target", "parent comment belongs to another movie, series or episode")
+	}
+	if limit := uc.cfg.Validation.CommentMaxDepth; limit > 0 && parent.Depth+1 > limit {
+		v.add("parent_id", "max_depth", "replies can be nested at most %d levels deep", limit)
+	}
+	return v.err()
+}
+
+// validatePerson проверяет поля персоны; фото необязательно.
+func (uc *Usecase) validatePerson(person *entities.Person) error {
+	limits := uc.cfg.Validation
+	var v violations
+	v.text("name", person.Name, true, limits.PersonNameMaxLength)
+	v.text("bio", person.Bio, false, limits.PersonBioMaxLength)
+	if person.PhotoURL != "" {
+		v.url("photo_url", person.PhotoURL)
+	}
+	return v.err()
+}
+
+// validateCredits проверяет титры фильма: роль из entities.CreditRoles, персонаж только у актёров,
+// без повторов одной и той же записи. Существование персон проверяет внешний ключ в БД.
+func (uc *Usecase) validateCredits(credits []entities.Credit) error {
+	var v violations
+	if max := uc.cfg.Validation.MaxCredits; max > 0 && len(credits) > max {
+		v.add("credits", "max_items", "at most %d credits are allowed", max)
+	}
+
+	type creditKey struct {
+		personID  int
+		role      string
+		character string
+	}
+	seen := make(map[creditKey]bool, len(credits))
+	for i, c := range credits {
+		field := fmt.Sprintf("credits[%d]", i)
+		if c.PersonID <= 0 {
+			v.add(field+".person_id", "required", "person_id is required")
+		}
+		if !slices.Contains(entities.CreditRoles, c.Role) {
+			v.add(field+".role", "enum", "role must be one of %s", strings.Join(entities.CreditRoles, ", "))
+		}
+		if c.Character != "" && c.Role != entities.CreditRoleActor {
+			v.add(field+".character", "actor_only", "character can be set only for role %s", entities.CreditRoleActor)
+		}
+		v.text(field+".character", c.Character, false, 255)
+		if c.BillingOrder < 0 {
+			v.add(field+".billing_order", "min", "billing_order must not be negative")
+		}
+		key := creditKey{c.PersonID, c.Role, c.Character}
+		if seen[key] {
+			v.add(field, "unique", "person %d is credited as %s more than once", c.PersonID, c.Role)
+		}
+		seen[key] = true
+	}
+	return v.err()
+}
+
+// validateGenreName проверяет название жанра.
+func (uc *Usecase) validateGenreName(name string) error {
+	var v violations
+	v.text("name", name, true, uc.cfg.Validation.GenreNameMaxLength)
+	return v.err()
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"movieService/internal/config"
+	"movieService/internal/entities"
+	"movieService/internal/errs"
+	"movieService/internal/repository/postgres"
+)
+
+// genresRepository отдаёт фиксированный список жанров; остальные методы остаются nil-интерфейсом.
+type genresRepository struct {
+	postgres.InterfaceRepository
+
+	genres []int
+	err    error
+}
+
+func (r *genresRepository) ListGenres(context.Context) (*entities.ListGenresResponse, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	resp := &entities.ListGenresResponse{}
+	for _, id := range r.genres {
+		resp.Genres = append(resp.Genres, &entities.Genre{ID: id})
+	}
+	return resp, nil
+}
+
+// newTestUsecase собирает usecase с ограничениями из config/config.yaml.
+func newTestUsecase(repo postgres.InterfaceRepository) *Usecase {
+	cfg := &config.Config{Validation: config.ValidationConfig{
+		TitleMaxLength:       255,
+		DescriptionMaxLength: 5000,
+		DurationMaxMin:       1000,
+		MaxGenres:            3,
+		CommentMaxLength:     20,
+		CommentMaxDepth:      2,
+		GenreNameMaxLength:   10,
+	}}
+	uc, _ := NewUsecase(zap.NewNop(), repo, cfg, context.Background(), nil)
+	return uc
+}
+
+// violated возвращает нарушения ошибки валидации в виде "поле:правило".
+func violated(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var e *errs.Error
+	require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	require.Equal(t, errs.CodeInvalidArgument, e.Code)
+	out := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		assert.NotEmpty(t, v.Message, v.Field)
+		out = append(out, v.Field+":"+v.Rule)
+	}
+	return out
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestValidateMovie(t *testing.T) {
+	uc := newTestUsecase(&genresRepository{genres: []int{1, 2, 3}})
+	released := time.Date(1979, 5, 25, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		movie *entities.MovieDTO
+		want  []string
+	}{
+		{
+			name: "valid movie",
+			movie: &entities.MovieDTO{
+				Title: ptr("Alien"), VideoURL: ptr("https://cdn.example.com/alien.mp4"), CoverURL: ptr("http://cdn.example.com/alien.jpg"),
+				Description: ptr(""), ReleaseDate: &released, DurationMin: ptr(117), GenreIDs: []int{1, 2},
+			},
+		},
+		{
+			name: "every field invalid",
+			movie: &entities.MovieDTO{
+				Title: ptr("  "), VideoURL: ptr("ftp://cdn.example.com/a.mp4"), CoverURL: ptr(""),
+				Description: ptr(strings.Repeat("a", 5001)), ReleaseDate: &time.Time{}, DurationMin: ptr(0), GenreIDs: []int{1, 1, 9},
+			},
+			want: []string{
+				"title:required", "description:max_length", "video_url:url", "cover_url:required",
+				"release_date:required", "duration_min:min", "genre_ids[1]:unique", "genre_ids[2]:exists",
+			},
+		},
+		{
+			name:  "partial update checks only set fields",
+			movie: &entities.MovieDTO{DurationMin: ptr(1001)},
+			want:  []string{"duration_min:max"},
+		},
+		{
+			name:  "title is counted in characters",
+			movie: &entities.MovieDTO{Title: ptr(strings.Repeat("ё", 255))},
+		},
+		{
+			name:  "too many genres",
+			movie: &entities.MovieDTO{GenreIDs: []int{1, 2, 3, 1}},
+			want:  []string{"genre_ids:max_items", "genre_ids[3]:unique"},
+		},
+		{
+			name:  "empty patch",
+			movie: &entities.MovieDTO{},
+		},
+		{
+			name:  "cleared genres",
+			movie: &entities.MovieDTO{GenreIDs: []int{}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, violated(t, uc.validateMovie(context.Background(), c.movie)))
+		})
+	}
+}
+
+func TestValidateGenreIDsRepositoryError(t *testing.T) {
+	boom := errors.New("boom")
+	uc := newTestUsecase(&genresRepository{err: boom})
+
+	var v violations
+	assert.ErrorIs(t, uc.validateGenreIDs(context.Background(), []int{1}, &v), boom)
+	assert.Empty(t, v)
+	// без жанров репозиторий не нужен
+	assert.NoError(t, uc.validateGenreIDs(context.Background(), nil, &v))
+}
+
+func TestValidateScore(t *testing.T) {
+	cases := []struct {
+		score int
+		want  []string
+	}{
+		{0, []string{"score:range"}},
+		{1, nil},
+		{10, nil},
+		{11, []string{"score:range"}},
+		{-3, []string{"score:range"}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, violated(t, validateScore(c.score)), c.score)
+	}
+}
+
+func TestValidateCommentText(t *testing.T) {
+	uc := newTestUsecase(nil)
+	cases := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{"text:required"}},
+		{" \n\t", []string{"text:required"}},
+		{"great movie", nil},
+		{strings.Repeat("ж", 20), nil},
+		{strings.Repeat("ж", 21), []string{"text:max_length"}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, violated(t, uc.validateCommentText(c.text)), c.text)
+	}
+}
+
+func TestValidateGenreName(t *testing.T) {
+	uc := newTestUsecase(nil)
+	cases := []struct {
+		name string
+		want []string
+	}{
+		{"", []string{"name:required"}},
+		{"drama", nil},
+		{"documentary", []string{"name:max_length"}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, violated(t, uc.validateGenreName(c.name)), c.name)
+	}
+}
+
+func TestValidationErrorPayload(t *testing.T) {
+	err := validateScore(0)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidArgument)
+	var e *errs.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, []errs.Violation{{Field: "score", Rule: "range", Message: "score must be between 1 and 10"}}, e.Violations)
+}
+
+func TestDateOrZero(t *testing.T) {
+	assert.True(t, dateOrZero(nil).IsZero())
+}
@@ -0,0 +1,2 @@
+ALTER TABLE ratings
+    DROP CONSTRAINT IF EXISTS ratings_movie_user_key;
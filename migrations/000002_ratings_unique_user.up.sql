@@ -0,0 +1,9 @@
+-- оставляем по одной (самой свежей) оценке на пару фильм/пользователь
+DELETE FROM ratings r
+USING ratings newer
+WHERE r.movie_id = newer.movie_id
+  AND r.user_id = newer.user_id
+  AND (r.updated_at, r.id) < (newer.updated_at, newer.id);
+
+ALTER TABLE ratings
+    ADD CONSTRAINT ratings_movie_user_key UNIQUE (movie_id, user_id);
@@ -0,0 +1,23 @@
+-- агрегаты оценок фильма; пересчитываются в транзакциях CreateRating/DeleteRating
+CREATE TABLE IF NOT EXISTS movie_rating_stats
+(
+    movie_id   INTEGER      PRIMARY KEY REFERENCES movies (id) ON DELETE CASCADE,
+    votes      INTEGER      NOT NULL DEFAULT 0,
+    average    NUMERIC(4,2) NOT NULL DEFAULT 0,
+    -- histogram[i] — число оценок со score = i
+    histogram  INTEGER[]    NOT NULL DEFAULT array_fill(0, ARRAY [10]),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+INSERT INTO movie_rating_stats (movie_id, votes, average, histogram)
+SELECT r.movie_id,
+       COUNT(*),
+       AVG(r.score)::NUMERIC(4,2),
+       ARRAY(SELECT COUNT(r2.id)::INTEGER
+             FROM generate_series(1, 10) AS s(score)
+             LEFT JOIN ratings r2 ON r2.movie_id = r.movie_id AND r2.score = s.score
+             GROUP BY s.score
+             ORDER BY s.score)
+FROM ratings r
+GROUP BY r.movie_id
+ON CONFLICT (movie_id) DO NOTHING;
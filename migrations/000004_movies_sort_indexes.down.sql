@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_movie_rating_stats_average;
+DROP INDEX IF EXISTS idx_movies_created_at_id;
+DROP INDEX IF EXISTS idx_movies_duration_min_id;
+DROP INDEX IF EXISTS idx_movies_release_date_id;
+DROP INDEX IF EXISTS idx_movies_title_id;
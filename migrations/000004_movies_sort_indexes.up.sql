@@ -0,0 +1,6 @@
+-- индексы под сортировку ListMovies (m.id — тай-брейкер для стабильных страниц)
+CREATE INDEX IF NOT EXISTS idx_movies_title_id ON movies (title, id);
+CREATE INDEX IF NOT EXISTS idx_movies_release_date_id ON movies (release_date, id);
+CREATE INDEX IF NOT EXISTS idx_movies_duration_min_id ON movies (duration_min, id);
+CREATE INDEX IF NOT EXISTS idx_movies_created_at_id ON movies (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_movie_rating_stats_average ON movie_rating_stats (average, movie_id);
@@ -0,0 +1,10 @@
+-- полнотекстовый поиск: название весит больше описания; конфигурация simple — без стемминга,
+-- одинаково работает для русских и английских названий и подходит для поиска по префиксу
+ALTER TABLE movies
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+        GENERATED ALWAYS AS (
+            setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+        ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_movies_search_vector ON movies USING GIN (search_vector);
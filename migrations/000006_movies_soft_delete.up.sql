@@ -0,0 +1,6 @@
+-- мягкое удаление: фильм скрыт из выдачи, но оценки и комментарии сохраняются до purge
+ALTER TABLE movies
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- для фоновой очистки удалённых фильмов старше срока хранения
+CREATE INDEX IF NOT EXISTS idx_movies_deleted_at ON movies (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_comments_parent;
+DROP INDEX IF EXISTS idx_comments_movie_parent;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS edited,
+    DROP COLUMN IF EXISTS depth,
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,9 @@
+-- ответы на комментарии: parent_id ссылается на родителя, depth — уровень вложенности (0 у корневых)
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES comments (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS depth     SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS edited    BOOLEAN  NOT NULL DEFAULT false;
+
+-- выборка ветки: комментарии фильма одного уровня по порядку и ответы на конкретный комментарий
+CREATE INDEX IF NOT EXISTS idx_comments_movie_parent ON comments (movie_id, parent_id, id);
+CREATE INDEX IF NOT EXISTS idx_comments_parent ON comments (parent_id) WHERE parent_id IS NOT NULL;
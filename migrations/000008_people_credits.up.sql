@@ -0,0 +1,30 @@
+-- персоны: актёры, режиссёры, сценаристы
+CREATE TABLE IF NOT EXISTS people
+(
+    id         SERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    bio        TEXT         NOT NULL DEFAULT '',
+    birth_date DATE,
+    photo_url  TEXT         NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- участие персоны в фильме; character_name заполняется только у актёров
+CREATE TABLE IF NOT EXISTS movie_credits
+(
+    movie_id       INTEGER      NOT NULL REFERENCES movies (id),
+    person_id      INTEGER      NOT NULL REFERENCES people (id),
+    role           VARCHAR(16)  NOT NULL CHECK (role IN ('director', 'actor', 'writer')),
+    character_name VARCHAR(255) NOT NULL DEFAULT '',
+    billing_order  INTEGER      NOT NULL DEFAULT 0 CHECK (billing_order >= 0),
+    PRIMARY KEY (
+                 movie_id,
+                 person_id,
+                 role,
+                 character_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_people_name ON people (name);
+-- фильмография персоны и фильтр ListMovies по person_id
+CREATE INDEX IF NOT EXISTS idx_movie_credits_person ON movie_credits (person_id, movie_id);
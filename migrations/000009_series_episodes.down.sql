@@ -0,0 +1,23 @@
+DELETE FROM comments WHERE movie_id IS NULL;
+DELETE FROM ratings WHERE movie_id IS NULL;
+
+DROP INDEX IF EXISTS idx_comments_episode_parent;
+DROP INDEX IF EXISTS idx_comments_series_parent;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_target_check,
+    DROP COLUMN IF EXISTS episode_id,
+    DROP COLUMN IF EXISTS series_id,
+    ALTER COLUMN movie_id SET NOT NULL;
+
+ALTER TABLE ratings
+    DROP CONSTRAINT IF EXISTS ratings_episode_user_key,
+    DROP CONSTRAINT IF EXISTS ratings_series_user_key,
+    DROP CONSTRAINT IF EXISTS ratings_target_check,
+    DROP COLUMN IF EXISTS episode_id,
+    DROP COLUMN IF EXISTS series_id,
+    ALTER COLUMN movie_id SET NOT NULL;
+
+DROP TABLE IF EXISTS episodes;
+DROP TABLE IF EXISTS seasons;
+DROP TABLE IF EXISTS series;
@@ -0,0 +1,54 @@
+-- сериалы: сезоны и эпизоды связаны внешними ключами, эпизод — самостоятельная единица просмотра
+CREATE TABLE IF NOT EXISTS series
+(
+    id          SERIAL PRIMARY KEY,
+    title       VARCHAR(255) NOT NULL,
+    cover_url   TEXT         NOT NULL DEFAULT '',
+    description TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS seasons
+(
+    id        SERIAL PRIMARY KEY,
+    series_id INTEGER      NOT NULL REFERENCES series (id) ON DELETE CASCADE,
+    number    INTEGER      NOT NULL CHECK (number > 0),
+    title     VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (series_id, number)
+);
+
+CREATE TABLE IF NOT EXISTS episodes
+(
+    id           SERIAL PRIMARY KEY,
+    season_id    INTEGER      NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    number       INTEGER      NOT NULL CHECK (number > 0),
+    title        VARCHAR(255) NOT NULL,
+    video_url    TEXT         NOT NULL,
+    description  TEXT         NOT NULL DEFAULT '',
+    release_date DATE         NOT NULL,
+    duration_min INTEGER      NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    UNIQUE (season_id, number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_title_id ON series (title, id);
+
+-- оценки и комментарии относятся ровно к одному объекту: фильму, сериалу или эпизоду
+ALTER TABLE ratings
+    ALTER COLUMN movie_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS series_id  INTEGER REFERENCES series (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS episode_id INTEGER REFERENCES episodes (id) ON DELETE CASCADE,
+    ADD CONSTRAINT ratings_target_check CHECK (num_nonnulls(movie_id, series_id, episode_id) = 1),
+    ADD CONSTRAINT ratings_series_user_key UNIQUE (series_id, user_id),
+    ADD CONSTRAINT ratings_episode_user_key UNIQUE (episode_id, user_id);
+
+ALTER TABLE comments
+    ALTER COLUMN movie_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS series_id  INTEGER REFERENCES series (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS episode_id INTEGER REFERENCES episodes (id) ON DELETE CASCADE,
+    ADD CONSTRAINT comments_target_check CHECK (num_nonnulls(movie_id, series_id, episode_id) = 1);
+
+CREATE INDEX IF NOT EXISTS idx_comments_series_parent ON comments (series_id, parent_id, id) WHERE series_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_episode_parent ON comments (episode_id, parent_id, id) WHERE episode_id IS NOT NULL;
@@ -0,0 +1,12 @@
+-- переводы названия и описания фильма; исходный текст в movies написан на локали по умолчанию (locale.default)
+CREATE TABLE IF NOT EXISTS movie_translations
+(
+    movie_id    INTEGER      NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    -- языковой тег в нижнем регистре: en, pt-br
+    locale      VARCHAR(35)  NOT NULL CHECK (locale ~ '^[a-z]{2,3}(-[a-z0-9]{2,8})*$'),
+    title       VARCHAR(255) NOT NULL,
+    description TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    PRIMARY KEY (movie_id, locale)
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_movies_unpublish_at;
+DROP INDEX IF EXISTS idx_movies_publish_at;
+
+ALTER TABLE movies
+    DROP CONSTRAINT IF EXISTS movies_scheduled_publish_at,
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,19 @@
+-- состояние публикации; уже существующие фильмы видны всем, поэтому считаются опубликованными
+ALTER TABLE movies
+    ADD COLUMN IF NOT EXISTS status       VARCHAR(16) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'scheduled', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS publish_at   TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMPTZ;
+
+UPDATE movies SET publish_at = created_at WHERE publish_at IS NULL;
+
+-- новые фильмы создаются черновиками
+ALTER TABLE movies ALTER COLUMN status SET DEFAULT 'draft';
+
+-- у запланированного фильма всегда есть время публикации
+ALTER TABLE movies
+    ADD CONSTRAINT movies_scheduled_publish_at CHECK (status <> 'scheduled' OR publish_at IS NOT NULL);
+
+-- фоновая задача ищет фильмы, у которых наступило время публикации или снятия с неё
+CREATE INDEX IF NOT EXISTS idx_movies_publish_at ON movies (publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_movies_unpublish_at ON movies (unpublish_at) WHERE status = 'published' AND unpublish_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_movie_certifications_country;
+DROP TABLE IF EXISTS movie_content_descriptors;
+DROP TABLE IF EXISTS movie_certifications;
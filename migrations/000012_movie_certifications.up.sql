@@ -0,0 +1,22 @@
+-- возрастные рейтинги фильма по странам: обозначение в системе страны и минимальный возраст зрителя
+CREATE TABLE IF NOT EXISTS movie_certifications
+(
+    movie_id      INTEGER     NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    -- код страны ISO 3166-1 alpha-2 в верхнем регистре: US, RU
+    country       CHAR(2)     NOT NULL CHECK (country ~ '^[A-Z]{2}$'),
+    certification VARCHAR(16) NOT NULL CHECK (certification <> ''),
+    min_age       SMALLINT    NOT NULL CHECK (min_age BETWEEN 0 AND 21),
+    PRIMARY KEY (movie_id, country)
+);
+
+-- описания содержимого, на которых основаны рейтинги
+CREATE TABLE IF NOT EXISTS movie_content_descriptors
+(
+    movie_id   INTEGER     NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    descriptor VARCHAR(32) NOT NULL CHECK (descriptor IN ('violence', 'language', 'sex', 'nudity', 'drugs',
+                                                          'horror', 'discrimination', 'gambling')),
+    PRIMARY KEY (movie_id, descriptor)
+);
+
+-- фильтр ListMovies и SearchMovies по max_age и certifications в стране
+CREATE INDEX IF NOT EXISTS idx_movie_certifications_country ON movie_certifications (country, min_age, movie_id);
@@ -0,0 +1,27 @@
+package jwt
+
+import "context"
+
+// claimsCtxKey — ключ, под которым данные аутентифицированного пользователя хранятся в context.Context.
+type claimsCtxKey struct{}
+
+// WithClaims возвращает копию контекста с данными пользователя из проверенного токена.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsCtxKey{}, claims)
+}
+
+// ClaimsFromContext достаёт данные пользователя, положенные WithClaims.
+// Второе значение false, если запрос не был аутентифицирован.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsCtxKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// UserIDFromContext достаёт ID пользователя из данных, положенных WithClaims.
+func UserIDFromContext(ctx context.Context) (int32, bool) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return claims.UserID, true
+}
@@ -2,9 +2,9 @@ package jwt
 
 // InterfaceJWT описывает методы для работы с JWT.
 type InterfaceJWT interface {
-	// Generate создаёт новый JWT на основе userID.
-	Generate(userID int32) (string, error)
+	// Generate создаёт новый JWT на основе userID и роли пользователя.
+	Generate(userID int32, role string) (string, error)
 
-	// Validate разбирает токен, проверяет подпись и возвращает userID из claims.
-	Validate(tokenString string) (int32, error)
+	// Validate разбирает токен, проверяет подпись и возвращает userID и роль из claims.
+	Validate(tokenString string) (*Claims, error)
 }
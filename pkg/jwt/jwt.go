@@ -11,6 +11,16 @@ import (
 // claimsKey — имя поля в claim, где лежит ID пользователя.
 const claimsKey = "user_id"
 
+// roleKey — имя поля в claim, где лежит роль пользователя.
+const roleKey = "role"
+
+// maxAgeKey и countryKey — поля claim детского профиля: выдача фильмов ограничивается
+// возрастным рейтингом страны countryKey не старше maxAgeKey.
+const (
+	maxAgeKey  = "max_age"
+	countryKey = "country"
+)
+
 // ServiceJWT — конкретная реализация Service.
 type ServiceJWT struct {
 	secret     []byte
@@ -27,10 +37,11 @@ func NewJWT(secret string, ttlHours time.Duration) *ServiceJWT {
 func (j *ServiceJWT) OnStart(_ context.Context) error { return nil }
 func (j *ServiceJWT) OnStop(_ context.Context) error  { return nil }
 
-// Generate формирует новый токен с полем claimsKey = userID и exp = now + ttlInHours.
-func (j *ServiceJWT) Generate(userID int32) (string, error) {
+// Generate формирует новый токен с полями claimsKey = userID, roleKey = role и exp = now + ttlInHours.
+func (j *ServiceJWT) Generate(userID int32, role string) (string, error) {
 	claims := jwt.MapClaims{
 		claimsKey: userID,
+		roleKey:   role,
 		"exp":     time.Now().Add(j.ttlInHours * time.Hour).Unix(),
 	}
 
@@ -38,8 +49,9 @@ func (j *ServiceJWT) Generate(userID int32) (string, error) {
 	return token.SignedString(j.secret)
 }
 
-// Validate парсит токен, проверяет подпись и возвращает userID.
-func (j *ServiceJWT) Validate(tokenString string) (int32, error) {
+// Validate парсит токен, проверяет подпись и возвращает userID, роль и ограничения детского профиля.
+// Токены без claim roleKey считаются токенами роли RoleViewer.
+func (j *ServiceJWT) Validate(tokenString string) (*Claims, error) {
 	parsed, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Проверяем, что метод подписи — HMAC
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -48,25 +60,56 @@ func (j *ServiceJWT) Validate(tokenString string) (int32, error) {
 		return j.secret, nil
 	})
 	if err != nil || !parsed.Valid {
-		return 0, errors.New("invalid token")
+		return nil, errors.New("invalid token")
 	}
 
 	claims, ok := parsed.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, errors.New("invalid claims format")
+		return nil, errors.New("invalid claims format")
 	}
 
 	// Извлекаем userID из claims
 	raw, exists := claims[claimsKey]
 	if !exists {
-		return 0, errors.New("user_id not found in token")
+		return nil, errors.New("user_id not found in token")
 	}
 
 	// jwt.MapClaims всегда кладёт цифры как float64
 	uidFloat, ok := raw.(float64)
 	if !ok {
-		return 0, errors.New("user_id claim has unexpected type")
+		return nil, errors.New("user_id claim has unexpected type")
+	}
+
+	// Извлекаем роль; старые токены без роли — обычные зрители
+	role := RoleViewer
+	if rawRole, exists := claims[roleKey]; exists {
+		roleStr, ok := rawRole.(string)
+		if !ok {
+			return nil, errors.New("role claim has unexpected type")
+		}
+		if _, known := RoleLevels[roleStr]; !known {
+			return nil, errors.New("role claim has unknown value")
+		}
+		role = roleStr
 	}
 
-	return int32(uidFloat), nil
+	result := &Claims{UserID: int32(uidFloat), Role: role}
+
+	// Извлекаем ограничения детского профиля, если они есть
+	if rawAge, exists := claims[maxAgeKey]; exists {
+		ageFloat, ok := rawAge.(float64)
+		if !ok || ageFloat < 0 || ageFloat != float64(int32(ageFloat)) {
+			return nil, errors.New("max_age claim has unexpected value")
+		}
+		maxAge := int32(ageFloat)
+		result.MaxAge = &maxAge
+	}
+	if rawCountry, exists := claims[countryKey]; exists {
+		country, ok := rawCountry.(string)
+		if !ok {
+			return nil, errors.New("country claim has unexpected type")
+		}
+		result.Country = country
+	}
+	return result, nil
 }
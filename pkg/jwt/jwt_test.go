@@ -0,0 +1,91 @@
+package jwt_test
+
+import (
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"movieService/pkg/jwt"
+)
+
+func TestGenerateValidateRole(t *testing.T) {
+	svc := jwt.NewJWT("secret", 1)
+
+	token, err := svc.Generate(42, jwt.RoleModerator)
+	require.NoError(t, err)
+
+	claims, err := svc.Validate(token)
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), claims.UserID)
+	assert.Equal(t, jwt.RoleModerator, claims.Role)
+}
+
+func TestValidateWithoutRoleIsViewer(t *testing.T) {
+	svc := jwt.NewJWT("secret", 1)
+
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, gojwt.MapClaims{
+		"user_id": 7,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	claims, err := svc.Validate(token)
+	require.NoError(t, err)
+	assert.Equal(t, jwt.RoleViewer, claims.Role)
+}
+
+func TestValidateKidsProfile(t *testing.T) {
+	svc := jwt.NewJWT("secret", 1)
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, gojwt.MapClaims{
+		"user_id": 7,
+		"max_age": 12,
+		"country": "US",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	claims, err := svc.Validate(token)
+	require.NoError(t, err)
+	require.NotNil(t, claims.MaxAge)
+	assert.Equal(t, int32(12), *claims.MaxAge)
+	assert.Equal(t, "US", claims.Country)
+
+	token, err = svc.Generate(7, jwt.RoleViewer)
+	require.NoError(t, err)
+	claims, err = svc.Validate(token)
+	require.NoError(t, err)
+	assert.Nil(t, claims.MaxAge)
+}
+
+func TestValidateInvalidMaxAge(t *testing.T) {
+	svc := jwt.NewJWT("secret", 1)
+	token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, gojwt.MapClaims{
+		"user_id": 7,
+		"max_age": "twelve",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = svc.Validate(token)
+	assert.Error(t, err)
+}
+
+func TestValidateUnknownRole(t *testing.T) {
+	svc := jwt.NewJWT("secret", 1)
+
+	token, err := svc.Generate(1, "superuser")
+	require.NoError(t, err)
+
+	_, err = svc.Validate(token)
+	assert.Error(t, err)
+}
+
+func TestHasRole(t *testing.T) {
+	assert.True(t, jwt.HasRole(jwt.RoleAdmin, jwt.RoleModerator))
+	assert.True(t, jwt.HasRole(jwt.RoleModerator, jwt.RoleModerator))
+	assert.False(t, jwt.HasRole(jwt.RoleViewer, jwt.RoleModerator))
+	assert.False(t, jwt.HasRole("", jwt.RoleViewer))
+}
@@ -0,0 +1,37 @@
+package jwt
+
+// Роли пользователей, которые кладутся в claim roleKey.
+const (
+	RoleViewer    = "viewer"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// RoleLevels — уровень каждой роли: роль с большим уровнем включает права младших.
+var RoleLevels = map[string]int{
+	RoleViewer:    1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+// Claims — данные пользователя из проверенного токена.
+type Claims struct {
+	UserID int32
+	Role   string
+
+	// ограничения детского профиля: максимальный возрастной рейтинг (nil — без ограничения)
+	// и страна, по рейтингам которой он проверяется (пусто — страна по умолчанию)
+	MaxAge  *int32
+	Country string
+}
+
+// HasRole сообщает, достаточно ли роли role хотя бы для одной из required.
+func HasRole(role string, required ...string) bool {
+	level := RoleLevels[role]
+	for _, r := range required {
+		if need, ok := RoleLevels[r]; ok && level >= need {
+			return true
+		}
+	}
+	return false
+}
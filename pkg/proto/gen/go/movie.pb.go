@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -28,6 +29,7 @@ type Genre struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MovieCount    int32                  `protobuf:"varint,3,opt,name=movie_count,json=movieCount,proto3" json:"movie_count,omitempty"` // количество фильмов жанра, заполняется только в ListGenres
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,21 +78,40 @@ func (x *Genre) GetName() string {
 	return ""
 }
 
+func (x *Genre) GetMovieCount() int32 {
+	if x != nil {
+		return x.MovieCount
+	}
+	return 0
+}
+
 // Фильм
 type Movie struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	VideoUrl      string                 `protobuf:"bytes,3,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	DurationMin   int32                  `protobuf:"varint,7,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
-	Genres        []*Genre               `protobuf:"bytes,8,rep,name=genres,proto3" json:"genres,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	VideoUrl    string                 `protobuf:"bytes,3,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	CoverUrl    string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationMin int32                  `protobuf:"varint,7,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
+	Genres      []*Genre               `protobuf:"bytes,8,rep,name=genres,proto3" json:"genres,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RatingStats *RatingStats           `protobuf:"bytes,11,opt,name=rating_stats,json=ratingStats,proto3" json:"rating_stats,omitempty"`
+	// актёры и съёмочная группа по порядку в титрах, заполняется только в GetMovie
+	Credits []*Credit `protobuf:"bytes,12,rep,name=credits,proto3" json:"credits,omitempty"`
+	// локаль, на которой отданы title и description
+	Locale string `protobuf:"bytes,13,opt,name=locale,proto3" json:"locale,omitempty"`
+	// состояние публикации: draft, scheduled, published, archived
+	Status      string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`       // не задано у черновиков
+	UnpublishAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"` // не задано, если снятие не запланировано
+	// возрастные рейтинги по странам и описания содержимого, заполняются только в GetMovie
+	Certifications     []*Certification `protobuf:"bytes,17,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	ContentDescriptors []string         `protobuf:"bytes,18,rep,name=content_descriptors,json=contentDescriptors,proto3" json:"content_descriptors,omitempty"` // violence, language, sex, nudity, drugs, horror, discrimination, gambling
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Movie) Reset() {
@@ -193,33 +214,86 @@ func (x *Movie) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Рейтинг (звёзды) для фильма
-type Rating struct {
+func (x *Movie) GetRatingStats() *RatingStats {
+	if x != nil {
+		return x.RatingStats
+	}
+	return nil
+}
+
+func (x *Movie) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *Movie) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Movie) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Movie) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Movie) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+func (x *Movie) GetCertifications() []*Certification {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *Movie) GetContentDescriptors() []string {
+	if x != nil {
+		return x.ContentDescriptors
+	}
+	return nil
+}
+
+// Возрастной рейтинг фильма в одной стране
+type Certification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MovieId       int32                  `protobuf:"varint,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`              // код страны ISO 3166-1 alpha-2: US, RU
+	Certification string                 `protobuf:"bytes,2,opt,name=certification,proto3" json:"certification,omitempty"`  // обозначение в системе рейтингов страны: PG-13, 16+
+	MinAge        int32                  `protobuf:"varint,3,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"` // возраст, с которого фильм можно смотреть без взрослых
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Rating) Reset() {
-	*x = Rating{}
+func (x *Certification) Reset() {
+	*x = Certification{}
 	mi := &file_pkg_proto_movie_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Rating) String() string {
+func (x *Certification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rating) ProtoMessage() {}
+func (*Certification) ProtoMessage() {}
 
-func (x *Rating) ProtoReflect() protoreflect.Message {
+func (x *Certification) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_movie_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -231,80 +305,59 @@ func (x *Rating) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
-func (*Rating) Descriptor() ([]byte, []int) {
+// Deprecated: Use Certification.ProtoReflect.Descriptor instead.
+func (*Certification) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Rating) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Rating) GetMovieId() int32 {
+func (x *Certification) GetCountry() string {
 	if x != nil {
-		return x.MovieId
+		return x.Country
 	}
-	return 0
+	return ""
 }
 
-func (x *Rating) GetUserId() int32 {
+func (x *Certification) GetCertification() string {
 	if x != nil {
-		return x.UserId
+		return x.Certification
 	}
-	return 0
+	return ""
 }
 
-func (x *Rating) GetScore() int32 {
+func (x *Certification) GetMinAge() int32 {
 	if x != nil {
-		return x.Score
+		return x.MinAge
 	}
 	return 0
 }
 
-func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-// Комментарий к фильму
-type Comment struct {
+// Перевод названия и описания фильма
+type MovieTranslation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MovieId       int32                  `protobuf:"varint,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // языковой тег в нижнем регистре: en, pt-br
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *MovieTranslation) Reset() {
+	*x = MovieTranslation{}
 	mi := &file_pkg_proto_movie_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *MovieTranslation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*MovieTranslation) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *MovieTranslation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_movie_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,78 +369,81 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use MovieTranslation.ProtoReflect.Descriptor instead.
+func (*MovieTranslation) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Comment) GetId() int32 {
+func (x *MovieTranslation) GetMovieId() int32 {
 	if x != nil {
-		return x.Id
+		return x.MovieId
 	}
 	return 0
 }
 
-func (x *Comment) GetMovieId() int32 {
+func (x *MovieTranslation) GetLocale() string {
 	if x != nil {
-		return x.MovieId
+		return x.Locale
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetUserId() int32 {
+func (x *MovieTranslation) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetText() string {
+func (x *MovieTranslation) GetDescription() string {
 	if x != nil {
-		return x.Text
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MovieTranslation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *MovieTranslation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-// 1. GET /api/v1/movies? page, per_page, genres=...
-type ListMoviesRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Page    int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                      // номер страницы (1-based)
-	PerPage int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // элементов на страницу
-	// если необходимо фильтровать по множеству жанров, передаём их ID
-	GenreIds      []int32 `protobuf:"varint,3,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+// Персона: актёр, режиссёр или сценарист
+type Person struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // не задана, если неизвестна
+	PhotoUrl      string                 `protobuf:"bytes,5,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMoviesRequest) Reset() {
-	*x = ListMoviesRequest{}
+func (x *Person) Reset() {
+	*x = Person{}
 	mi := &file_pkg_proto_movie_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMoviesRequest) String() string {
+func (x *Person) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMoviesRequest) ProtoMessage() {}
+func (*Person) ProtoMessage() {}
 
-func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
+func (x *Person) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_movie_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,54 +455,86 @@ func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMoviesRequest.ProtoReflect.Descriptor instead.
-func (*ListMoviesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListMoviesRequest) GetPage() int32 {
+func (x *Person) GetId() int32 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListMoviesRequest) GetPerPage() int32 {
+func (x *Person) GetName() string {
 	if x != nil {
-		return x.PerPage
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMoviesRequest) GetGenreIds() []int32 {
+func (x *Person) GetBio() string {
 	if x != nil {
-		return x.GenreIds
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Person) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
 	}
 	return nil
 }
 
-type ListMoviesResponse struct {
+func (x *Person) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *Person) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Участие персоны в фильме
+type Credit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // общее количество фильмов, подходящих под фильтр
+	PersonId      int32                  `protobuf:"varint,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	PersonName    string                 `protobuf:"bytes,2,opt,name=person_name,json=personName,proto3" json:"person_name,omitempty"`        // только в ответах, в запросах игнорируется
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                      // director | actor | writer
+	Character     string                 `protobuf:"bytes,4,opt,name=character,proto3" json:"character,omitempty"`                            // имя персонажа, только для role = actor
+	BillingOrder  int32                  `protobuf:"varint,5,opt,name=billing_order,json=billingOrder,proto3" json:"billing_order,omitempty"` // порядок в титрах, по возрастанию
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMoviesResponse) Reset() {
-	*x = ListMoviesResponse{}
+func (x *Credit) Reset() {
+	*x = Credit{}
 	mi := &file_pkg_proto_movie_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMoviesResponse) String() string {
+func (x *Credit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMoviesResponse) ProtoMessage() {}
+func (*Credit) ProtoMessage() {}
 
-func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
+func (x *Credit) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_movie_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,47 +546,74 @@ func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMoviesResponse.ProtoReflect.Descriptor instead.
-func (*ListMoviesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Credit.ProtoReflect.Descriptor instead.
+func (*Credit) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListMoviesResponse) GetMovies() []*Movie {
+func (x *Credit) GetPersonId() int32 {
 	if x != nil {
-		return x.Movies
+		return x.PersonId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMoviesResponse) GetTotal() int32 {
+func (x *Credit) GetPersonName() string {
 	if x != nil {
-		return x.Total
+		return x.PersonName
+	}
+	return ""
+}
+
+func (x *Credit) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Credit) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *Credit) GetBillingOrder() int32 {
+	if x != nil {
+		return x.BillingOrder
 	}
 	return 0
 }
 
-// 2. GET /api/v1/movies/{id}
-type GetMovieRequest struct {
+// Фильм в фильмографии персоны
+type FilmographyEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,3,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Character     string                 `protobuf:"bytes,6,opt,name=character,proto3" json:"character,omitempty"`
+	BillingOrder  int32                  `protobuf:"varint,7,opt,name=billing_order,json=billingOrder,proto3" json:"billing_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMovieRequest) Reset() {
-	*x = GetMovieRequest{}
+func (x *FilmographyEntry) Reset() {
+	*x = FilmographyEntry{}
 	mi := &file_pkg_proto_movie_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMovieRequest) String() string {
+func (x *FilmographyEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMovieRequest) ProtoMessage() {}
+func (*FilmographyEntry) ProtoMessage() {}
 
-func (x *GetMovieRequest) ProtoReflect() protoreflect.Message {
+func (x *FilmographyEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_movie_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -510,48 +625,3472 @@ func (x *GetMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMovieRequest.ProtoReflect.Descriptor instead.
-func (*GetMovieRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilmographyEntry.ProtoReflect.Descriptor instead.
+func (*FilmographyEntry) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMovieRequest) GetId() int32 {
+func (x *FilmographyEntry) GetMovieId() int32 {
 	if x != nil {
-		return x.Id
+		return x.MovieId
 	}
 	return 0
 }
 
-// 3. POST /api/v1/movies
-type CreateMovieRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	VideoUrl    string                 `protobuf:"bytes,2,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	CoverUrl    string                 `protobuf:"bytes,3,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	DurationMin int32                  `protobuf:"varint,6,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
-	// передаём просто список ID жанров
-	GenreIds      []int32 `protobuf:"varint,7,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FilmographyEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *CreateMovieRequest) Reset() {
-	*x = CreateMovieRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FilmographyEntry) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
 }
 
-func (x *CreateMovieRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FilmographyEntry) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
 }
 
-func (*CreateMovieRequest) ProtoMessage() {}
+func (x *FilmographyEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FilmographyEntry) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *FilmographyEntry) GetBillingOrder() int32 {
+	if x != nil {
+		return x.BillingOrder
+	}
+	return 0
+}
+
+// Агрегаты оценок фильма: средний балл, число голосов и распределение по баллам
+type RatingStats struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Average float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	Votes   int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// histogram[i] — число оценок со score = i+1 (всегда 10 элементов)
+	Histogram     []int32 `protobuf:"varint,3,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingStats) Reset() {
+	*x = RatingStats{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingStats) ProtoMessage() {}
+
+func (x *RatingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingStats.ProtoReflect.Descriptor instead.
+func (*RatingStats) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RatingStats) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RatingStats) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *RatingStats) GetHistogram() []int32 {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+// Сериал; сезоны заполняются только в GetSeries
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,3,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SeasonCount   int32                  `protobuf:"varint,7,opt,name=season_count,json=seasonCount,proto3" json:"season_count,omitempty"`
+	EpisodeCount  int32                  `protobuf:"varint,8,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	RatingStats   *RatingStats           `protobuf:"bytes,9,opt,name=rating_stats,json=ratingStats,proto3" json:"rating_stats,omitempty"`
+	Seasons       []*Season              `protobuf:"bytes,10,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Series) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Series) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Series) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *Series) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Series) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Series) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Series) GetSeasonCount() int32 {
+	if x != nil {
+		return x.SeasonCount
+	}
+	return 0
+}
+
+func (x *Series) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *Series) GetRatingStats() *RatingStats {
+	if x != nil {
+		return x.RatingStats
+	}
+	return nil
+}
+
+func (x *Series) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+// Сезон сериала
+type Season struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeriesId      int32                  `protobuf:"varint,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"` // порядковый номер в сериале, с 1
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	EpisodeCount  int32                  `protobuf:"varint,5,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Season) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Season) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Season) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Season) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Season) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+// Эпизод — самостоятельная единица просмотра со своим видео
+type Episode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeriesId      int32                  `protobuf:"varint,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	SeasonId      int32                  `protobuf:"varint,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonNumber  int32                  `protobuf:"varint,4,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	Number        int32                  `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"` // порядковый номер в сезоне, с 1
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	VideoUrl      string                 `protobuf:"bytes,7,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationMin   int32                  `protobuf:"varint,10,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RatingStats   *RatingStats           `protobuf:"bytes,13,opt,name=rating_stats,json=ratingStats,proto3" json:"rating_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Episode) Reset() {
+	*x = Episode{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Episode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Episode) ProtoMessage() {}
+
+func (x *Episode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Episode.ProtoReflect.Descriptor instead.
+func (*Episode) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Episode) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Episode) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Episode) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *Episode) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *Episode) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Episode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Episode) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *Episode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Episode) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *Episode) GetDurationMin() int32 {
+	if x != nil {
+		return x.DurationMin
+	}
+	return 0
+}
+
+func (x *Episode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Episode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Episode) GetRatingStats() *RatingStats {
+	if x != nil {
+		return x.RatingStats
+	}
+	return nil
+}
+
+// Рейтинг (звёзды) для фильма, сериала или эпизода: задан ровно один из movie_id, series_id, episode_id
+type Rating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId       int32                  `protobuf:"varint,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SeriesId      int32                  `protobuf:"varint,7,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32                  `protobuf:"varint,8,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Rating) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rating) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *Rating) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Rating) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Rating) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// Комментарий к фильму, сериалу или эпизоду: задан ровно один из movie_id, series_id, episode_id
+type Comment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId    int32                  `protobuf:"varint,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	UserId     int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text       string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentId   int32                  `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`        // 0 — корневой комментарий
+	Depth      int32                  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`                              // уровень вложенности, 0 у корневых
+	Edited     bool                   `protobuf:"varint,9,opt,name=edited,proto3" json:"edited,omitempty"`                            // текст менялся автором после публикации
+	ReplyCount int32                  `protobuf:"varint,10,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"` // число прямых ответов
+	// ветка ответов, заполняется только в ListComments с with_replies
+	Replies       []*Comment `protobuf:"bytes,11,rep,name=replies,proto3" json:"replies,omitempty"`
+	SeriesId      int32      `protobuf:"varint,12,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32      `protobuf:"varint,13,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Comment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Comment) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Comment) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Comment) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// 1. GET /api/v1/movies? page, per_page, genres=...
+type ListMoviesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Page    int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                      // номер страницы (1-based)
+	PerPage int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // элементов на страницу
+	// если необходимо фильтровать по множеству жанров, передаём их ID
+	GenreIds []int32 `protobuf:"varint,3,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	// поле сортировки: id, title, release_date, duration_min, created_at, rating (средний балл); по умолчанию id
+	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// направление сортировки: asc (по умолчанию) или desc
+	SortOrder string `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// курсор из next_page_token предыдущего ответа; если задан, page игнорируется
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// только фильмы, в которых участвует персона (в любой роли); 0 — без фильтра
+	PersonId int32 `protobuf:"varint,7,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	// предпочитаемые языки в формате Accept-Language ("en-US, en;q=0.8"); пусто — локаль по умолчанию
+	Locale string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	// фильтр по состоянию публикации, только для администраторов; остальным видны только опубликованные фильмы
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// только фильмы с возрастным рейтингом страны country не старше max_age;
+	// фильмы без рейтинга в этой стране при фильтре не показываются
+	MaxAge *int32 `protobuf:"varint,10,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	// только фильмы с одним из рейтингов страны country: PG, PG-13
+	Certifications []string `protobuf:"bytes,11,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	// страна для max_age и certifications; пусто — страна по умолчанию
+	Country       string `protobuf:"bytes,12,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMoviesRequest) Reset() {
+	*x = ListMoviesRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoviesRequest) ProtoMessage() {}
+
+func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ListMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetGenreIds() []int32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *ListMoviesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetPersonId() int32 {
+	if x != nil {
+		return x.PersonId
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetMaxAge() int32 {
+	if x != nil && x.MaxAge != nil {
+		return *x.MaxAge
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetCertifications() []string {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *ListMoviesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ListMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // общее количество фильмов, подходящих под фильтр (не считается при page_token)
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // пусто, если страниц больше нет
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMoviesResponse) Reset() {
+	*x = ListMoviesResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoviesResponse) ProtoMessage() {}
+
+func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ListMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMoviesResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *ListMoviesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMoviesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 1.1. GET /api/v1/movies/search? q, page, per_page, genres=...
+type SearchMoviesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Query   string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                     // поисковая строка; каждое слово ищется по префиксу
+	Page    int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                      // номер страницы (1-based)
+	PerPage int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // элементов на страницу
+	// дополнительный фильтр по жанрам, как в ListMoviesRequest
+	GenreIds []int32 `protobuf:"varint,4,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	// фильтр по возрастному рейтингу, как в ListMoviesRequest
+	MaxAge         *int32   `protobuf:"varint,5,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	Certifications []string `protobuf:"bytes,6,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	Country        string   `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchMoviesRequest) Reset() {
+	*x = SearchMoviesRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoviesRequest) ProtoMessage() {}
+
+func (x *SearchMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoviesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchMoviesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchMoviesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *SearchMoviesRequest) GetGenreIds() []int32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *SearchMoviesRequest) GetMaxAge() int32 {
+	if x != nil && x.MaxAge != nil {
+		return *x.MaxAge
+	}
+	return 0
+}
+
+func (x *SearchMoviesRequest) GetCertifications() []string {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *SearchMoviesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type SearchMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"` // отсортированы по релевантности
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`  // общее количество найденных фильмов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMoviesResponse) Reset() {
+	*x = SearchMoviesResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoviesResponse) ProtoMessage() {}
+
+func (x *SearchMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoviesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchMoviesResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *SearchMoviesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 2. GET /api/v1/movies/{id}
+type GetMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// предпочитаемые языки в формате Accept-Language, как в ListMoviesRequest
+	Locale        string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieRequest) Reset() {
+	*x = GetMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieRequest) ProtoMessage() {}
+
+func (x *GetMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMovieRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// 3. POST /api/v1/movies
+type CreateMovieRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	VideoUrl    string                 `protobuf:"bytes,2,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	CoverUrl    string                 `protobuf:"bytes,3,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationMin int32                  `protobuf:"varint,6,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
+	// передаём просто список ID жанров
+	GenreIds      []int32 `protobuf:"varint,7,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieRequest) Reset() {
+	*x = CreateMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieRequest) ProtoMessage() {}
+
+func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieRequest.ProtoReflect.Descriptor instead.
+func (*CreateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateMovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *CreateMovieRequest) GetDurationMin() int32 {
+	if x != nil {
+		return x.DurationMin
+	}
+	return 0
+}
+
+func (x *CreateMovieRequest) GetGenreIds() []int32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+type CreateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieResponse) Reset() {
+	*x = CreateMovieResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieResponse) ProtoMessage() {}
+
+func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieResponse.ProtoReflect.Descriptor instead.
+func (*CreateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+// 4. DELETE /api/v1/movies/{id}
+// Мягкое удаление: фильм скрывается из выдачи, оценки и комментарии сохраняются
+type DeleteMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieRequest) Reset() {
+	*x = DeleteMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieRequest) ProtoMessage() {}
+
+func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 4.1. POST /api/v1/movies/{id}/restore
+// Восстановление мягко удалённого фильма
+type RestoreMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMovieRequest) Reset() {
+	*x = RestoreMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMovieRequest) ProtoMessage() {}
+
+func (x *RestoreMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMovieRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 4.2. POST /api/v1/movies/{id}/purge
+// Окончательное удаление мягко удалённого фильма вместе с оценками и комментариями
+type PurgeMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeMovieRequest) Reset() {
+	*x = PurgeMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeMovieRequest) ProtoMessage() {}
+
+func (x *PurgeMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeMovieRequest.ProtoReflect.Descriptor instead.
+func (*PurgeMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 4.3. POST /api/v1/movies/{id}/publish
+// Публикация сразу; с unpublish_at фильм будет снят с публикации в указанное время
+type PublishMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishMovieRequest) Reset() {
+	*x = PublishMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishMovieRequest) ProtoMessage() {}
+
+func (x *PublishMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishMovieRequest.ProtoReflect.Descriptor instead.
+func (*PublishMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishMovieRequest) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+// 4.4. POST /api/v1/movies/{id}/schedule
+// Публикация в будущем: фоновая задача опубликует фильм в publish_at
+type ScheduleMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMovieRequest) Reset() {
+	*x = ScheduleMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMovieRequest) ProtoMessage() {}
+
+func (x *ScheduleMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMovieRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScheduleMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleMovieRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ScheduleMovieRequest) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+// 4.5. POST /api/v1/movies/{id}/archive
+// Снятие с публикации
+type ArchiveMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveMovieRequest) Reset() {
+	*x = ArchiveMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveMovieRequest) ProtoMessage() {}
+
+func (x *ArchiveMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveMovieRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArchiveMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 4.6. POST /api/v1/movies/{id}/draft
+// Возврат в черновики со сбросом расписания
+type RevertMovieToDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertMovieToDraftRequest) Reset() {
+	*x = RevertMovieToDraftRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertMovieToDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertMovieToDraftRequest) ProtoMessage() {}
+
+func (x *RevertMovieToDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertMovieToDraftRequest.ProtoReflect.Descriptor instead.
+func (*RevertMovieToDraftRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevertMovieToDraftRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 13. PUT /api/v1/movies/{id}
+// Полная замена фильма, включая список жанров
+type UpdateMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	VideoUrl      string                 `protobuf:"bytes,3,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationMin   int32                  `protobuf:"varint,7,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
+	GenreIds      []int32                `protobuf:"varint,8,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMovieRequest) Reset() {
+	*x = UpdateMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieRequest) ProtoMessage() {}
+
+func (x *UpdateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *UpdateMovieRequest) GetDurationMin() int32 {
+	if x != nil {
+		return x.DurationMin
+	}
+	return 0
+}
+
+func (x *UpdateMovieRequest) GetGenreIds() []int32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+type UpdateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMovieResponse) Reset() {
+	*x = UpdateMovieResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieResponse) ProtoMessage() {}
+
+func (x *UpdateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+// 14. PATCH /api/v1/movies/{id}
+// Частичное обновление: меняются только поля, перечисленные в update_mask
+// (title, video_url, cover_url, description, release_date, duration_min, genre_ids)
+type PatchMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	VideoUrl      string                 `protobuf:"bytes,3,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	DurationMin   int32                  `protobuf:"varint,7,opt,name=duration_min,json=durationMin,proto3" json:"duration_min,omitempty"`
+	GenreIds      []int32                `protobuf:"varint,8,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchMovieRequest) Reset() {
+	*x = PatchMovieRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchMovieRequest) ProtoMessage() {}
+
+func (x *PatchMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchMovieRequest.ProtoReflect.Descriptor instead.
+func (*PatchMovieRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PatchMovieRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchMovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PatchMovieRequest) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *PatchMovieRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *PatchMovieRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PatchMovieRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *PatchMovieRequest) GetDurationMin() int32 {
+	if x != nil {
+		return x.DurationMin
+	}
+	return 0
+}
+
+func (x *PatchMovieRequest) GetGenreIds() []int32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *PatchMovieRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// 14.1. GET /api/v1/movies/{id}/translations
+type ListMovieTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovieTranslationsRequest) Reset() {
+	*x = ListMovieTranslationsRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovieTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovieTranslationsRequest) ProtoMessage() {}
+
+func (x *ListMovieTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovieTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMovieTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMovieTranslationsRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+type ListMovieTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*MovieTranslation    `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"` // по возрастанию locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovieTranslationsResponse) Reset() {
+	*x = ListMovieTranslationsResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovieTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovieTranslationsResponse) ProtoMessage() {}
+
+func (x *ListMovieTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovieTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMovieTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListMovieTranslationsResponse) GetTranslations() []*MovieTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+// 14.2. PUT /api/v1/movies/{id}/translations/{locale}
+// Создание или замена перевода
+type SetMovieTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieTranslationRequest) Reset() {
+	*x = SetMovieTranslationRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieTranslationRequest) ProtoMessage() {}
+
+func (x *SetMovieTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetMovieTranslationRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *SetMovieTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 14.3. DELETE /api/v1/movies/{id}/translations/{locale}
+type DeleteMovieTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieTranslationRequest) Reset() {
+	*x = DeleteMovieTranslationRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteMovieTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteMovieTranslationRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *DeleteMovieTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// 14.4. PUT /api/v1/movies/{id}/certifications
+// Полная замена возрастных рейтингов и описаний содержимого; пустые списки их удаляют
+type SetMovieCertificationsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MovieId            int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Certifications     []*Certification       `protobuf:"bytes,2,rep,name=certifications,proto3" json:"certifications,omitempty"` // не больше одного рейтинга на страну
+	ContentDescriptors []string               `protobuf:"bytes,3,rep,name=content_descriptors,json=contentDescriptors,proto3" json:"content_descriptors,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetMovieCertificationsRequest) Reset() {
+	*x = SetMovieCertificationsRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieCertificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieCertificationsRequest) ProtoMessage() {}
+
+func (x *SetMovieCertificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieCertificationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieCertificationsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetMovieCertificationsRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *SetMovieCertificationsRequest) GetCertifications() []*Certification {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *SetMovieCertificationsRequest) GetContentDescriptors() []string {
+	if x != nil {
+		return x.ContentDescriptors
+	}
+	return nil
+}
+
+// ----- Запросы и ответы для работы с рейтингами -----
+// 5. GET /api/v1/movies/{id}/ratings? page, per_page
+type ListRatingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MovieId   int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage   int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // курсор из next_page_token; если задан, page игнорируется
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,5,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,6,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatingsRequest) Reset() {
+	*x = ListRatingsRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatingsRequest) ProtoMessage() {}
+
+func (x *ListRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRatingsRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *ListRatingsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRatingsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListRatingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRatingsRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *ListRatingsRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+type ListRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*Rating              `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // не считается при page_token
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatingsResponse) Reset() {
+	*x = ListRatingsResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatingsResponse) ProtoMessage() {}
+
+func (x *ListRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListRatingsResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *ListRatingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRatingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 6. GET /api/v1/movies/{id}/ratings/{rid}
+type GetRatingRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MovieId  int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	RatingId int32                  `protobuf:"varint,2,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingRequest) Reset() {
+	*x = GetRatingRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingRequest) ProtoMessage() {}
+
+func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRatingRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *GetRatingRequest) GetRatingId() int32 {
+	if x != nil {
+		return x.RatingId
+	}
+	return 0
+}
+
+func (x *GetRatingRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *GetRatingRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// 7. POST /api/v1/movies/{id}/ratings
+// Один пользователь — одна оценка фильма: повторный запрос обновляет score
+type CreateRatingRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MovieId int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	UserId  int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // игнорируется: автор берётся из JWT
+	Score   int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`                 // от 1 до 10
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,4,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,5,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatingRequest) Reset() {
+	*x = CreateRatingRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatingRequest) ProtoMessage() {}
+
+func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatingRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateRatingRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *CreateRatingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateRatingRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CreateRatingRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CreateRatingRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+type CreateRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *Rating                `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // true — оценка создана, false — обновлена существующая
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatingResponse) Reset() {
+	*x = CreateRatingResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatingResponse) ProtoMessage() {}
+
+func (x *CreateRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatingResponse.ProtoReflect.Descriptor instead.
+func (*CreateRatingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateRatingResponse) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *CreateRatingResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// 7.1. GET /api/v1/movies/{id}/ratings/me
+// Оценка фильма текущим пользователем (из JWT)
+type GetMyRatingRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MovieId int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyRatingRequest) Reset() {
+	*x = GetMyRatingRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRatingRequest) ProtoMessage() {}
+
+func (x *GetMyRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetMyRatingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMyRatingRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *GetMyRatingRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *GetMyRatingRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// 8. DELETE /api/v1/movies/{id}/ratings/{rid}
+type DeleteRatingRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MovieId  int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	RatingId int32                  `protobuf:"varint,2,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatingRequest) Reset() {
+	*x = DeleteRatingRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatingRequest) ProtoMessage() {}
+
+func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRatingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteRatingRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *DeleteRatingRequest) GetRatingId() int32 {
+	if x != nil {
+		return x.RatingId
+	}
+	return 0
+}
+
+func (x *DeleteRatingRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *DeleteRatingRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// ----- Запросы и ответы для работы с комментариями -----
+// 9. GET /api/v1/movies/{id}/comments? page, per_page
+type ListCommentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MovieId   int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage   int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // курсор из next_page_token; если задан, page игнорируется
+	// 0 — корневые комментарии фильма, иначе — прямые ответы на комментарий parent_id
+	ParentId int32 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// true — в каждый комментарий страницы вкладывается вся ветка ответов
+	WithReplies bool `protobuf:"varint,6,opt,name=with_replies,json=withReplies,proto3" json:"with_replies,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,7,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,8,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListCommentsRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetWithReplies() bool {
+	if x != nil {
+		return x.WithReplies
+	}
+	return false
+}
+
+func (x *ListCommentsRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // не считается при page_token
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 10. GET /api/v1/movies/{id}/comments/{cid}
+type GetCommentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MovieId   int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	CommentId int32                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentRequest) Reset() {
+	*x = GetCommentRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRequest) ProtoMessage() {}
+
+func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCommentRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetCommentId() int32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// 11. POST /api/v1/movies/{id}/comments
+type CreateCommentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MovieId  int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	UserId   int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // игнорируется: автор берётся из JWT
+	Text     string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ParentId int32                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID комментария того же фильма, на который отвечаем; 0 — новая ветка
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,5,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,6,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateCommentRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+type CreateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 11.1. PATCH /api/v1/movies/{id}/comments/{cid}
+// Редактировать текст может только автор; комментарий помечается как edited
+type EditCommentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MovieId   int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	CommentId int32                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Text      string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,4,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,5,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EditCommentRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetCommentId() int32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// 12. DELETE /api/v1/movies/{id}/comments/{cid}
+// Вместе с комментарием удаляются все ответы на него
+type DeleteCommentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MovieId   int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	CommentId int32                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// вместо movie_id — сериал или эпизод
+	SeriesId      int32 `protobuf:"varint,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	EpisodeId     int32 `protobuf:"varint,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteCommentRequest) GetMovieId() int32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetEpisodeId() int32 {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return 0
+}
+
+// ----- Запросы и ответы для работы с жанрами -----
+// 15. GET /api/v1/genres
+type ListGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresRequest) Reset() {
+	*x = ListGenresRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresRequest) ProtoMessage() {}
+
+func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
+func (*ListGenresRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{49}
+}
+
+type ListGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresResponse) Reset() {
+	*x = ListGenresResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresResponse) ProtoMessage() {}
+
+func (x *ListGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresResponse.ProtoReflect.Descriptor instead.
+func (*ListGenresResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListGenresResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *ListGenresResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 16. POST /api/v1/genres
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreResponse) Reset() {
+	*x = CreateGenreResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreResponse) ProtoMessage() {}
+
+func (x *CreateGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreResponse.ProtoReflect.Descriptor instead.
+func (*CreateGenreResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+// 17. PUT /api/v1/genres/{id}
+type UpdateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenreRequest) Reset() {
+	*x = UpdateGenreRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenreRequest) ProtoMessage() {}
+
+func (x *UpdateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateGenreRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenreResponse) Reset() {
+	*x = UpdateGenreResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenreResponse) ProtoMessage() {}
+
+func (x *UpdateGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGenreResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+// 18. DELETE /api/v1/genres/{id}?force=true
+type DeleteGenreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// false — отказ, если жанр привязан к фильмам; true — жанр удаляется вместе с привязками
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreRequest) Reset() {
+	*x = DeleteGenreRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreRequest) ProtoMessage() {}
+
+func (x *DeleteGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGenreRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteGenreRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteGenreRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// ----- Запросы и ответы для работы с персонами -----
+// 19. GET /api/v1/people? q, page, per_page
+type ListPeopleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // подстрока имени без учёта регистра; пусто — все
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleRequest) Reset() {
+	*x = ListPeopleRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleRequest) ProtoMessage() {}
 
-func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[7]
+func (x *ListPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,82 +4101,155 @@ func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMovieRequest.ProtoReflect.Descriptor instead.
-func (*CreateMovieRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListPeopleRequest.ProtoReflect.Descriptor instead.
+func (*ListPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateMovieRequest) GetTitle() string {
+func (x *ListPeopleRequest) GetQuery() string {
 	if x != nil {
-		return x.Title
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CreateMovieRequest) GetVideoUrl() string {
+func (x *ListPeopleRequest) GetPage() int32 {
 	if x != nil {
-		return x.VideoUrl
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateMovieRequest) GetCoverUrl() string {
+func (x *ListPeopleRequest) GetPerPage() int32 {
 	if x != nil {
-		return x.CoverUrl
+		return x.PerPage
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateMovieRequest) GetDescription() string {
+type ListPeopleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	People        []*Person              `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"` // отсортированы по имени
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleResponse) Reset() {
+	*x = ListPeopleResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleResponse) ProtoMessage() {}
+
+func (x *ListPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[57]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMovieRequest) GetReleaseDate() *timestamppb.Timestamp {
+// Deprecated: Use ListPeopleResponse.ProtoReflect.Descriptor instead.
+func (*ListPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListPeopleResponse) GetPeople() []*Person {
 	if x != nil {
-		return x.ReleaseDate
+		return x.People
 	}
 	return nil
 }
 
-func (x *CreateMovieRequest) GetDurationMin() int32 {
+func (x *ListPeopleResponse) GetTotal() int32 {
 	if x != nil {
-		return x.DurationMin
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CreateMovieRequest) GetGenreIds() []int32 {
+// 20. GET /api/v1/people/{id}
+type GetPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonRequest) Reset() {
+	*x = GetPersonRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRequest) ProtoMessage() {}
+
+func (x *GetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[58]
 	if x != nil {
-		return x.GenreIds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateMovieResponse struct {
+// Deprecated: Use GetPersonRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPersonRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 21. POST /api/v1/people
+type CreatePersonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMovieResponse) Reset() {
-	*x = CreateMovieResponse{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[8]
+func (x *CreatePersonRequest) Reset() {
+	*x = CreatePersonRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMovieResponse) String() string {
+func (x *CreatePersonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMovieResponse) ProtoMessage() {}
+func (*CreatePersonRequest) ProtoMessage() {}
 
-func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[8]
+func (x *CreatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,41 +4260,61 @@ func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMovieResponse.ProtoReflect.Descriptor instead.
-func (*CreateMovieResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreatePersonRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateMovieResponse) GetMovie() *Movie {
+func (x *CreatePersonRequest) GetName() string {
 	if x != nil {
-		return x.Movie
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
 	}
 	return nil
 }
 
-// 4. DELETE /api/v1/movies/{id}
-type DeleteMovieRequest struct {
+func (x *CreatePersonRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type CreatePersonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMovieRequest) Reset() {
-	*x = DeleteMovieRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[9]
+func (x *CreatePersonResponse) Reset() {
+	*x = CreatePersonResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMovieRequest) String() string {
+func (x *CreatePersonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMovieRequest) ProtoMessage() {}
+func (*CreatePersonResponse) ProtoMessage() {}
 
-func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[9]
+func (x *CreatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,44 +4325,45 @@ func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMovieRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMovieRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreatePersonResponse.ProtoReflect.Descriptor instead.
+func (*CreatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteMovieRequest) GetId() int32 {
+func (x *CreatePersonResponse) GetPerson() *Person {
 	if x != nil {
-		return x.Id
+		return x.Person
 	}
-	return 0
+	return nil
 }
 
-// ----- Запросы и ответы для работы с рейтингами -----
-// 5. GET /api/v1/movies/{id}/ratings? page, per_page
-type ListRatingsRequest struct {
+// 22. PUT /api/v1/people/{id}
+type UpdatePersonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,5,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRatingsRequest) Reset() {
-	*x = ListRatingsRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[10]
+func (x *UpdatePersonRequest) Reset() {
+	*x = UpdatePersonRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRatingsRequest) String() string {
+func (x *UpdatePersonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRatingsRequest) ProtoMessage() {}
+func (*UpdatePersonRequest) ProtoMessage() {}
 
-func (x *ListRatingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[10]
+func (x *UpdatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,55 +4374,68 @@ func (x *ListRatingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRatingsRequest.ProtoReflect.Descriptor instead.
-func (*ListRatingsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdatePersonRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListRatingsRequest) GetMovieId() int32 {
+func (x *UpdatePersonRequest) GetId() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListRatingsRequest) GetPage() int32 {
+func (x *UpdatePersonRequest) GetName() string {
 	if x != nil {
-		return x.Page
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListRatingsRequest) GetPerPage() int32 {
+func (x *UpdatePersonRequest) GetBio() string {
 	if x != nil {
-		return x.PerPage
+		return x.Bio
 	}
-	return 0
+	return ""
 }
 
-type ListRatingsResponse struct {
+func (x *UpdatePersonRequest) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *UpdatePersonRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type UpdatePersonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ratings       []*Rating              `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRatingsResponse) Reset() {
-	*x = ListRatingsResponse{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[11]
+func (x *UpdatePersonResponse) Reset() {
+	*x = UpdatePersonResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRatingsResponse) String() string {
+func (x *UpdatePersonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRatingsResponse) ProtoMessage() {}
+func (*UpdatePersonResponse) ProtoMessage() {}
 
-func (x *ListRatingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[11]
+func (x *UpdatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,49 +4446,43 @@ func (x *ListRatingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRatingsResponse.ProtoReflect.Descriptor instead.
-func (*ListRatingsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdatePersonResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListRatingsResponse) GetRatings() []*Rating {
+func (x *UpdatePersonResponse) GetPerson() *Person {
 	if x != nil {
-		return x.Ratings
+		return x.Person
 	}
 	return nil
 }
 
-func (x *ListRatingsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// 6. GET /api/v1/movies/{id}/ratings/{rid}
-type GetRatingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	RatingId      int32                  `protobuf:"varint,2,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"`
+// 23. DELETE /api/v1/people/{id}?force=true
+type DeletePersonRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// false — отказ, если персона указана в титрах фильмов; true — удаляется вместе с участием в фильмах
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRatingRequest) Reset() {
-	*x = GetRatingRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[12]
+func (x *DeletePersonRequest) Reset() {
+	*x = DeletePersonRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRatingRequest) String() string {
+func (x *DeletePersonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRatingRequest) ProtoMessage() {}
+func (*DeletePersonRequest) ProtoMessage() {}
 
-func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[12]
+func (x *DeletePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,50 +4493,48 @@ func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRatingRequest.ProtoReflect.Descriptor instead.
-func (*GetRatingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeletePersonRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetRatingRequest) GetMovieId() int32 {
+func (x *DeletePersonRequest) GetId() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetRatingRequest) GetRatingId() int32 {
+func (x *DeletePersonRequest) GetForce() bool {
 	if x != nil {
-		return x.RatingId
+		return x.Force
 	}
-	return 0
+	return false
 }
 
-// 7. POST /api/v1/movies/{id}/ratings
-type CreateRatingRequest struct {
+// 24. GET /api/v1/people/{id}/movies
+type ListFilmographyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // от 1 до 10
+	PersonId      int32                  `protobuf:"varint,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRatingRequest) Reset() {
-	*x = CreateRatingRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[13]
+func (x *ListFilmographyRequest) Reset() {
+	*x = ListFilmographyRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRatingRequest) String() string {
+func (x *ListFilmographyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRatingRequest) ProtoMessage() {}
+func (*ListFilmographyRequest) ProtoMessage() {}
 
-func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[13]
+func (x *ListFilmographyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,54 +4545,41 @@ func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRatingRequest.ProtoReflect.Descriptor instead.
-func (*CreateRatingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateRatingRequest) GetMovieId() int32 {
-	if x != nil {
-		return x.MovieId
-	}
-	return 0
-}
-
-func (x *CreateRatingRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ListFilmographyRequest.ProtoReflect.Descriptor instead.
+func (*ListFilmographyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CreateRatingRequest) GetScore() int32 {
+func (x *ListFilmographyRequest) GetPersonId() int32 {
 	if x != nil {
-		return x.Score
+		return x.PersonId
 	}
 	return 0
 }
 
-type CreateRatingResponse struct {
+type ListFilmographyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rating        *Rating                `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Entries       []*FilmographyEntry    `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // от новых фильмов к старым
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRatingResponse) Reset() {
-	*x = CreateRatingResponse{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[14]
+func (x *ListFilmographyResponse) Reset() {
+	*x = ListFilmographyResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRatingResponse) String() string {
+func (x *ListFilmographyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRatingResponse) ProtoMessage() {}
+func (*ListFilmographyResponse) ProtoMessage() {}
 
-func (x *CreateRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[14]
+func (x *ListFilmographyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,42 +4590,50 @@ func (x *CreateRatingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRatingResponse.ProtoReflect.Descriptor instead.
-func (*CreateRatingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListFilmographyResponse.ProtoReflect.Descriptor instead.
+func (*ListFilmographyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreateRatingResponse) GetRating() *Rating {
+func (x *ListFilmographyResponse) GetPerson() *Person {
 	if x != nil {
-		return x.Rating
+		return x.Person
 	}
 	return nil
 }
 
-// 8. DELETE /api/v1/movies/{id}/ratings/{rid}
-type DeleteRatingRequest struct {
+func (x *ListFilmographyResponse) GetEntries() []*FilmographyEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 25. PUT /api/v1/movies/{id}/credits
+// Полная замена титров фильма; пустой список удаляет всех участников
+type SetMovieCreditsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	RatingId      int32                  `protobuf:"varint,2,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"`
+	Credits       []*Credit              `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRatingRequest) Reset() {
-	*x = DeleteRatingRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[15]
+func (x *SetMovieCreditsRequest) Reset() {
+	*x = SetMovieCreditsRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRatingRequest) String() string {
+func (x *SetMovieCreditsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRatingRequest) ProtoMessage() {}
+func (*SetMovieCreditsRequest) ProtoMessage() {}
 
-func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[15]
+func (x *SetMovieCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,51 +4644,50 @@ func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRatingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRatingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetMovieCreditsRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteRatingRequest) GetMovieId() int32 {
+func (x *SetMovieCreditsRequest) GetMovieId() int32 {
 	if x != nil {
 		return x.MovieId
 	}
 	return 0
 }
 
-func (x *DeleteRatingRequest) GetRatingId() int32 {
+func (x *SetMovieCreditsRequest) GetCredits() []*Credit {
 	if x != nil {
-		return x.RatingId
+		return x.Credits
 	}
-	return 0
+	return nil
 }
 
-// ----- Запросы и ответы для работы с комментариями -----
-// 9. GET /api/v1/movies/{id}/comments? page, per_page
-type ListCommentsRequest struct {
+// ----- Запросы и ответы для работы с сериалами -----
+// 26. GET /api/v1/series? page, per_page
+type ListSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsRequest) Reset() {
-	*x = ListCommentsRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[16]
+func (x *ListSeriesRequest) Reset() {
+	*x = ListSeriesRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsRequest) String() string {
+func (x *ListSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsRequest) ProtoMessage() {}
+func (*ListSeriesRequest) ProtoMessage() {}
 
-func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[16]
+func (x *ListSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,55 +4698,48 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *ListCommentsRequest) GetMovieId() int32 {
-	if x != nil {
-		return x.MovieId
-	}
-	return 0
+// Deprecated: Use ListSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListCommentsRequest) GetPage() int32 {
+func (x *ListSeriesRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListCommentsRequest) GetPerPage() int32 {
+func (x *ListSeriesRequest) GetPerPage() int32 {
 	if x != nil {
 		return x.PerPage
 	}
 	return 0
 }
 
-type ListCommentsResponse struct {
+type ListSeriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Series        []*Series              `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"` // отсортированы по названию
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsResponse) Reset() {
-	*x = ListCommentsResponse{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[17]
+func (x *ListSeriesResponse) Reset() {
+	*x = ListSeriesResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsResponse) String() string {
+func (x *ListSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsResponse) ProtoMessage() {}
+func (*ListSeriesResponse) ProtoMessage() {}
 
-func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[17]
+func (x *ListSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,49 +4750,48 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListCommentsResponse) GetComments() []*Comment {
+func (x *ListSeriesResponse) GetSeries() []*Series {
 	if x != nil {
-		return x.Comments
+		return x.Series
 	}
 	return nil
 }
 
-func (x *ListCommentsResponse) GetTotal() int32 {
+func (x *ListSeriesResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// 10. GET /api/v1/movies/{id}/comments/{cid}
-type GetCommentRequest struct {
+// 27. GET /api/v1/series/{id}
+type GetSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	CommentId     int32                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentRequest) Reset() {
-	*x = GetCommentRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[18]
+func (x *GetSeriesRequest) Reset() {
+	*x = GetSeriesRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentRequest) String() string {
+func (x *GetSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentRequest) ProtoMessage() {}
+func (*GetSeriesRequest) ProtoMessage() {}
 
-func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[18]
+func (x *GetSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,50 +4802,42 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetCommentRequest) GetMovieId() int32 {
-	if x != nil {
-		return x.MovieId
-	}
-	return 0
+// Deprecated: Use GetSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCommentRequest) GetCommentId() int32 {
+func (x *GetSeriesRequest) GetId() int32 {
 	if x != nil {
-		return x.CommentId
+		return x.Id
 	}
 	return 0
 }
 
-// 11. POST /api/v1/movies/{id}/comments
-type CreateCommentRequest struct {
+// 28. GET /api/v1/series/{id}/episodes? season
+type ListEpisodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	SeriesId      int32                  `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"` // номер сезона; 0 — все сезоны
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentRequest) Reset() {
-	*x = CreateCommentRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[19]
+func (x *ListEpisodesRequest) Reset() {
+	*x = ListEpisodesRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentRequest) String() string {
+func (x *ListEpisodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentRequest) ProtoMessage() {}
+func (*ListEpisodesRequest) ProtoMessage() {}
 
-func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[19]
+func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,54 +4848,92 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CreateCommentRequest) GetMovieId() int32 {
+func (x *ListEpisodesRequest) GetSeriesId() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.SeriesId
 	}
 	return 0
 }
 
-func (x *CreateCommentRequest) GetUserId() int32 {
+func (x *ListEpisodesRequest) GetSeason() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Season
 	}
 	return 0
 }
 
-func (x *CreateCommentRequest) GetText() string {
+type ListEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"` // по сезонам и номерам эпизодов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodesResponse) Reset() {
+	*x = ListEpisodesResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodesResponse) ProtoMessage() {}
+
+func (x *ListEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[71]
 	if x != nil {
-		return x.Text
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type CreateCommentResponse struct {
+// Deprecated: Use ListEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+// 29. GET /api/v1/episodes/{id}
+type GetEpisodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentResponse) Reset() {
-	*x = CreateCommentResponse{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[20]
+func (x *GetEpisodeRequest) Reset() {
+	*x = GetEpisodeRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentResponse) String() string {
+func (x *GetEpisodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentResponse) ProtoMessage() {}
+func (*GetEpisodeRequest) ProtoMessage() {}
 
-func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[20]
+func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,42 +4944,42 @@ func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
-func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CreateCommentResponse) GetComment() *Comment {
+func (x *GetEpisodeRequest) GetId() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// 12. DELETE /api/v1/movies/{id}/comments/{cid}
-type DeleteCommentRequest struct {
+// 30. GET /api/v1/episodes/{id}/next
+// Следующий эпизод того же сериала; после последнего эпизода сезона — первый эпизод следующего сезона
+type GetNextEpisodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       int32                  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
-	CommentId     int32                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	EpisodeId     int32                  `protobuf:"varint,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_pkg_proto_movie_proto_msgTypes[21]
+func (x *GetNextEpisodeRequest) Reset() {
+	*x = GetNextEpisodeRequest{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *GetNextEpisodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*GetNextEpisodeRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_movie_proto_msgTypes[21]
+func (x *GetNextEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,33 +4990,72 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetNextEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNextEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DeleteCommentRequest) GetMovieId() int32 {
+func (x *GetNextEpisodeRequest) GetEpisodeId() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.EpisodeId
 	}
 	return 0
 }
 
-func (x *DeleteCommentRequest) GetCommentId() int32 {
+type GetNextEpisodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"` // не задан, если эпизод последний в сериале
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextEpisodeResponse) Reset() {
+	*x = GetNextEpisodeResponse{}
+	mi := &file_pkg_proto_movie_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextEpisodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextEpisodeResponse) ProtoMessage() {}
+
+func (x *GetNextEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_movie_proto_msgTypes[74]
 	if x != nil {
-		return x.CommentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNextEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_movie_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetNextEpisodeResponse) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
 }
 
 var File_pkg_proto_movie_proto protoreflect.FileDescriptor
 
 const file_pkg_proto_movie_proto_rawDesc = "" +
 	"\n" +
-	"\x15pkg/proto/movie.proto\x12\x0emovie_proto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"+\n" +
+	"\x15pkg/proto/movie.proto\x12\x0emovie_proto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"L\n" +
 	"\x05Genre\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x90\x03\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vmovie_count\x18\x03 \x01(\x05R\n" +
+	"movieCount\"\xa4\x06\n" +
 	"\x05Movie\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
@@ -1376,7 +5069,96 @@ const file_pkg_proto_movie_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd8\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\frating_stats\x18\v \x01(\v2\x1b.movie_proto.v1.RatingStatsR\vratingStats\x120\n" +
+	"\acredits\x18\f \x03(\v2\x16.movie_proto.v1.CreditR\acredits\x12\x16\n" +
+	"\x06locale\x18\r \x01(\tR\x06locale\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\x12E\n" +
+	"\x0ecertifications\x18\x11 \x03(\v2\x1d.movie_proto.v1.CertificationR\x0ecertifications\x12/\n" +
+	"\x13content_descriptors\x18\x12 \x03(\tR\x12contentDescriptors\"h\n" +
+	"\rCertification\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12$\n" +
+	"\rcertification\x18\x02 \x01(\tR\rcertification\x12\x17\n" +
+	"\amin_age\x18\x03 \x01(\x05R\x06minAge\"\xf3\x01\n" +
+	"\x10MovieTranslation\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\x02\n" +
+	"\x06Person\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"birth_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x1b\n" +
+	"\tphoto_url\x18\x05 \x01(\tR\bphotoUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x01\n" +
+	"\x06Credit\x12\x1b\n" +
+	"\tperson_id\x18\x01 \x01(\x05R\bpersonId\x12\x1f\n" +
+	"\vperson_name\x18\x02 \x01(\tR\n" +
+	"personName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1c\n" +
+	"\tcharacter\x18\x04 \x01(\tR\tcharacter\x12#\n" +
+	"\rbilling_order\x18\x05 \x01(\x05R\fbillingOrder\"\xf6\x01\n" +
+	"\x10FilmographyEntry\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tcover_url\x18\x03 \x01(\tR\bcoverUrl\x12=\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1c\n" +
+	"\tcharacter\x18\x06 \x01(\tR\tcharacter\x12#\n" +
+	"\rbilling_order\x18\a \x01(\x05R\fbillingOrder\"[\n" +
+	"\vRatingStats\x12\x18\n" +
+	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\x12\x1c\n" +
+	"\thistogram\x18\x03 \x03(\x05R\thistogram\"\x9d\x03\n" +
+	"\x06Series\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tcover_url\x18\x03 \x01(\tR\bcoverUrl\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fseason_count\x18\a \x01(\x05R\vseasonCount\x12#\n" +
+	"\repisode_count\x18\b \x01(\x05R\fepisodeCount\x12>\n" +
+	"\frating_stats\x18\t \x01(\v2\x1b.movie_proto.v1.RatingStatsR\vratingStats\x120\n" +
+	"\aseasons\x18\n" +
+	" \x03(\v2\x16.movie_proto.v1.SeasonR\aseasons\"\x88\x01\n" +
+	"\x06Season\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\x05R\bseriesId\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12#\n" +
+	"\repisode_count\x18\x05 \x01(\x05R\fepisodeCount\"\xfd\x03\n" +
+	"\aEpisode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\x05R\bseriesId\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\x05R\bseasonId\x12#\n" +
+	"\rseason_number\x18\x04 \x01(\x05R\fseasonNumber\x12\x16\n" +
+	"\x06number\x18\x05 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x1b\n" +
+	"\tvideo_url\x18\a \x01(\tR\bvideoUrl\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12=\n" +
+	"\frelease_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12!\n" +
+	"\fduration_min\x18\n" +
+	" \x01(\x05R\vdurationMin\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\frating_stats\x18\r \x01(\v2\x1b.movie_proto.v1.RatingStatsR\vratingStats\"\x94\x02\n" +
 	"\x06Rating\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bmovie_id\x18\x02 \x01(\x05R\amovieId\x12\x17\n" +
@@ -1385,7 +5167,10 @@ const file_pkg_proto_movie_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd7\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tseries_id\x18\a \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\b \x01(\x05R\tepisodeId\"\xb2\x03\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bmovie_id\x18\x02 \x01(\x05R\amovieId\x12\x17\n" +
@@ -1394,16 +5179,55 @@ const file_pkg_proto_movie_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\x05R\bparentId\x12\x14\n" +
+	"\x05depth\x18\b \x01(\x05R\x05depth\x12\x16\n" +
+	"\x06edited\x18\t \x01(\bR\x06edited\x12\x1f\n" +
+	"\vreply_count\x18\n" +
+	" \x01(\x05R\n" +
+	"replyCount\x121\n" +
+	"\areplies\x18\v \x03(\v2\x17.movie_proto.v1.CommentR\areplies\x12\x1b\n" +
+	"\tseries_id\x18\f \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\r \x01(\x05R\tepisodeId\"\xef\x02\n" +
 	"\x11ListMoviesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1b\n" +
-	"\tgenre_ids\x18\x03 \x03(\x05R\bgenreIds\"Y\n" +
+	"\tgenre_ids\x18\x03 \x03(\x05R\bgenreIds\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tperson_id\x18\a \x01(\x05R\bpersonId\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\amax_age\x18\n" +
+	" \x01(\x05H\x00R\x06maxAge\x88\x01\x01\x12&\n" +
+	"\x0ecertifications\x18\v \x03(\tR\x0ecertifications\x12\x18\n" +
+	"\acountry\x18\f \x01(\tR\acountryB\n" +
+	"\n" +
+	"\b_max_age\"\x81\x01\n" +
 	"\x12ListMoviesResponse\x12-\n" +
 	"\x06movies\x18\x01 \x03(\v2\x15.movie_proto.v1.MovieR\x06movies\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"!\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xe3\x01\n" +
+	"\x13SearchMoviesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x1b\n" +
+	"\tgenre_ids\x18\x04 \x03(\x05R\bgenreIds\x12\x1c\n" +
+	"\amax_age\x18\x05 \x01(\x05H\x00R\x06maxAge\x88\x01\x01\x12&\n" +
+	"\x0ecertifications\x18\x06 \x03(\tR\x0ecertifications\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountryB\n" +
+	"\n" +
+	"\b_max_age\"[\n" +
+	"\x14SearchMoviesResponse\x12-\n" +
+	"\x06movies\x18\x01 \x03(\v2\x15.movie_proto.v1.MovieR\x06movies\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"9\n" +
 	"\x0fGetMovieRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x85\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"\x85\x02\n" +
 	"\x12CreateMovieRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
 	"\tvideo_url\x18\x02 \x01(\tR\bvideoUrl\x12\x1b\n" +
@@ -1415,62 +5239,273 @@ const file_pkg_proto_movie_proto_rawDesc = "" +
 	"\x13CreateMovieResponse\x12+\n" +
 	"\x05movie\x18\x01 \x01(\v2\x15.movie_proto.v1.MovieR\x05movie\"$\n" +
 	"\x12DeleteMovieRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"^\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"%\n" +
+	"\x13RestoreMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"#\n" +
+	"\x11PurgeMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"d\n" +
+	"\x13PublishMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12=\n" +
+	"\funpublish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\"\xa0\x01\n" +
+	"\x14ScheduleMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\"%\n" +
+	"\x13ArchiveMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"+\n" +
+	"\x19RevertMovieToDraftRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x95\x02\n" +
+	"\x12UpdateMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tvideo_url\x18\x03 \x01(\tR\bvideoUrl\x12\x1b\n" +
+	"\tcover_url\x18\x04 \x01(\tR\bcoverUrl\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12!\n" +
+	"\fduration_min\x18\a \x01(\x05R\vdurationMin\x12\x1b\n" +
+	"\tgenre_ids\x18\b \x03(\x05R\bgenreIds\"B\n" +
+	"\x13UpdateMovieResponse\x12+\n" +
+	"\x05movie\x18\x01 \x01(\v2\x15.movie_proto.v1.MovieR\x05movie\"\xd1\x02\n" +
+	"\x11PatchMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tvideo_url\x18\x03 \x01(\tR\bvideoUrl\x12\x1b\n" +
+	"\tcover_url\x18\x04 \x01(\tR\bcoverUrl\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12!\n" +
+	"\fduration_min\x18\a \x01(\x05R\vdurationMin\x12\x1b\n" +
+	"\tgenre_ids\x18\b \x03(\x05R\bgenreIds\x12;\n" +
+	"\vupdate_mask\x18\t \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"9\n" +
+	"\x1cListMovieTranslationsRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\"e\n" +
+	"\x1dListMovieTranslationsResponse\x12D\n" +
+	"\ftranslations\x18\x01 \x03(\v2 .movie_proto.v1.MovieTranslationR\ftranslations\"\x87\x01\n" +
+	"\x1aSetMovieTranslationRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"R\n" +
+	"\x1dDeleteMovieTranslationRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"\xb2\x01\n" +
+	"\x1dSetMovieCertificationsRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12E\n" +
+	"\x0ecertifications\x18\x02 \x03(\v2\x1d.movie_proto.v1.CertificationR\x0ecertifications\x12/\n" +
+	"\x13content_descriptors\x18\x03 \x03(\tR\x12contentDescriptors\"\xb9\x01\n" +
 	"\x12ListRatingsRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x03 \x01(\x05R\aperPage\"]\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tseries_id\x18\x05 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x06 \x01(\x05R\tepisodeId\"\x85\x01\n" +
 	"\x13ListRatingsResponse\x120\n" +
 	"\aratings\x18\x01 \x03(\v2\x16.movie_proto.v1.RatingR\aratings\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"J\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x86\x01\n" +
 	"\x10GetRatingRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1b\n" +
-	"\trating_id\x18\x02 \x01(\x05R\bratingId\"_\n" +
+	"\trating_id\x18\x02 \x01(\x05R\bratingId\x12\x1b\n" +
+	"\tseries_id\x18\x03 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\x05R\tepisodeId\"\x9b\x01\n" +
 	"\x13CreateRatingRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x05R\x05score\"F\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1b\n" +
+	"\tseries_id\x18\x04 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x05 \x01(\x05R\tepisodeId\"`\n" +
 	"\x14CreateRatingResponse\x12.\n" +
-	"\x06rating\x18\x01 \x01(\v2\x16.movie_proto.v1.RatingR\x06rating\"M\n" +
+	"\x06rating\x18\x01 \x01(\v2\x16.movie_proto.v1.RatingR\x06rating\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"k\n" +
+	"\x12GetMyRatingRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\x05R\tepisodeId\"\x89\x01\n" +
 	"\x13DeleteRatingRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1b\n" +
-	"\trating_id\x18\x02 \x01(\x05R\bratingId\"_\n" +
+	"\trating_id\x18\x02 \x01(\x05R\bratingId\x12\x1b\n" +
+	"\tseries_id\x18\x03 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\x05R\tepisodeId\"\xfa\x01\n" +
 	"\x13ListCommentsRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x03 \x01(\x05R\aperPage\"a\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\x05R\bparentId\x12!\n" +
+	"\fwith_replies\x18\x06 \x01(\bR\vwithReplies\x12\x1b\n" +
+	"\tseries_id\x18\a \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\b \x01(\x05R\tepisodeId\"\x89\x01\n" +
 	"\x14ListCommentsResponse\x123\n" +
 	"\bcomments\x18\x01 \x03(\v2\x17.movie_proto.v1.CommentR\bcomments\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x89\x01\n" +
 	"\x11GetCommentRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1d\n" +
 	"\n" +
-	"comment_id\x18\x02 \x01(\x05R\tcommentId\"^\n" +
+	"comment_id\x18\x02 \x01(\x05R\tcommentId\x12\x1b\n" +
+	"\tseries_id\x18\x03 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\x05R\tepisodeId\"\xb7\x01\n" +
 	"\x14CreateCommentRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
-	"\x04text\x18\x03 \x01(\tR\x04text\"J\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x05R\bparentId\x12\x1b\n" +
+	"\tseries_id\x18\x05 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x06 \x01(\x05R\tepisodeId\"J\n" +
 	"\x15CreateCommentResponse\x121\n" +
-	"\acomment\x18\x01 \x01(\v2\x17.movie_proto.v1.CommentR\acomment\"P\n" +
+	"\acomment\x18\x01 \x01(\v2\x17.movie_proto.v1.CommentR\acomment\"\x9e\x01\n" +
+	"\x12EditCommentRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x05R\tcommentId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1b\n" +
+	"\tseries_id\x18\x04 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x05 \x01(\x05R\tepisodeId\"\x8c\x01\n" +
 	"\x14DeleteCommentRequest\x12\x19\n" +
 	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x12\x1d\n" +
 	"\n" +
-	"comment_id\x18\x02 \x01(\x05R\tcommentId2\xe3\a\n" +
+	"comment_id\x18\x02 \x01(\x05R\tcommentId\x12\x1b\n" +
+	"\tseries_id\x18\x03 \x01(\x05R\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\x05R\tepisodeId\"\x13\n" +
+	"\x11ListGenresRequest\"Y\n" +
+	"\x12ListGenresResponse\x12-\n" +
+	"\x06genres\x18\x01 \x03(\v2\x15.movie_proto.v1.GenreR\x06genres\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"(\n" +
+	"\x12CreateGenreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"B\n" +
+	"\x13CreateGenreResponse\x12+\n" +
+	"\x05genre\x18\x01 \x01(\v2\x15.movie_proto.v1.GenreR\x05genre\"8\n" +
+	"\x12UpdateGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x13UpdateGenreResponse\x12+\n" +
+	"\x05genre\x18\x01 \x01(\v2\x15.movie_proto.v1.GenreR\x05genre\":\n" +
+	"\x12DeleteGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"X\n" +
+	"\x11ListPeopleRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"Z\n" +
+	"\x12ListPeopleResponse\x12.\n" +
+	"\x06people\x18\x01 \x03(\v2\x16.movie_proto.v1.PersonR\x06people\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\"\n" +
+	"\x10GetPersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x93\x01\n" +
+	"\x13CreatePersonRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"birth_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x1b\n" +
+	"\tphoto_url\x18\x04 \x01(\tR\bphotoUrl\"F\n" +
+	"\x14CreatePersonResponse\x12.\n" +
+	"\x06person\x18\x01 \x01(\v2\x16.movie_proto.v1.PersonR\x06person\"\xa3\x01\n" +
+	"\x13UpdatePersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"birth_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x1b\n" +
+	"\tphoto_url\x18\x05 \x01(\tR\bphotoUrl\"F\n" +
+	"\x14UpdatePersonResponse\x12.\n" +
+	"\x06person\x18\x01 \x01(\v2\x16.movie_proto.v1.PersonR\x06person\";\n" +
+	"\x13DeletePersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"5\n" +
+	"\x16ListFilmographyRequest\x12\x1b\n" +
+	"\tperson_id\x18\x01 \x01(\x05R\bpersonId\"\x85\x01\n" +
+	"\x17ListFilmographyResponse\x12.\n" +
+	"\x06person\x18\x01 \x01(\v2\x16.movie_proto.v1.PersonR\x06person\x12:\n" +
+	"\aentries\x18\x02 \x03(\v2 .movie_proto.v1.FilmographyEntryR\aentries\"e\n" +
+	"\x16SetMovieCreditsRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\x05R\amovieId\x120\n" +
+	"\acredits\x18\x02 \x03(\v2\x16.movie_proto.v1.CreditR\acredits\"B\n" +
+	"\x11ListSeriesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\"Z\n" +
+	"\x12ListSeriesResponse\x12.\n" +
+	"\x06series\x18\x01 \x03(\v2\x16.movie_proto.v1.SeriesR\x06series\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\"\n" +
+	"\x10GetSeriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"J\n" +
+	"\x13ListEpisodesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\x05R\bseriesId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\"K\n" +
+	"\x14ListEpisodesResponse\x123\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x17.movie_proto.v1.EpisodeR\bepisodes\"#\n" +
+	"\x11GetEpisodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"6\n" +
+	"\x15GetNextEpisodeRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\x05R\tepisodeId\"K\n" +
+	"\x16GetNextEpisodeResponse\x121\n" +
+	"\aepisode\x18\x01 \x01(\v2\x17.movie_proto.v1.EpisodeR\aepisode2\xb9\x1c\n" +
 	"\fMovieService\x12S\n" +
 	"\n" +
 	"ListMovies\x12!.movie_proto.v1.ListMoviesRequest\x1a\".movie_proto.v1.ListMoviesResponse\x12B\n" +
 	"\bGetMovie\x12\x1f.movie_proto.v1.GetMovieRequest\x1a\x15.movie_proto.v1.Movie\x12V\n" +
 	"\vCreateMovie\x12\".movie_proto.v1.CreateMovieRequest\x1a#.movie_proto.v1.CreateMovieResponse\x12I\n" +
-	"\vDeleteMovie\x12\".movie_proto.v1.DeleteMovieRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\vDeleteMovie\x12\".movie_proto.v1.DeleteMovieRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\fRestoreMovie\x12#.movie_proto.v1.RestoreMovieRequest\x1a\x15.movie_proto.v1.Movie\x12G\n" +
+	"\n" +
+	"PurgeMovie\x12!.movie_proto.v1.PurgeMovieRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\fPublishMovie\x12#.movie_proto.v1.PublishMovieRequest\x1a\x15.movie_proto.v1.Movie\x12L\n" +
+	"\rScheduleMovie\x12$.movie_proto.v1.ScheduleMovieRequest\x1a\x15.movie_proto.v1.Movie\x12J\n" +
+	"\fArchiveMovie\x12#.movie_proto.v1.ArchiveMovieRequest\x1a\x15.movie_proto.v1.Movie\x12V\n" +
+	"\x12RevertMovieToDraft\x12).movie_proto.v1.RevertMovieToDraftRequest\x1a\x15.movie_proto.v1.Movie\x12V\n" +
+	"\vUpdateMovie\x12\".movie_proto.v1.UpdateMovieRequest\x1a#.movie_proto.v1.UpdateMovieResponse\x12T\n" +
+	"\n" +
+	"PatchMovie\x12!.movie_proto.v1.PatchMovieRequest\x1a#.movie_proto.v1.UpdateMovieResponse\x12Y\n" +
+	"\fSearchMovies\x12#.movie_proto.v1.SearchMoviesRequest\x1a$.movie_proto.v1.SearchMoviesResponse\x12t\n" +
+	"\x15ListMovieTranslations\x12,.movie_proto.v1.ListMovieTranslationsRequest\x1a-.movie_proto.v1.ListMovieTranslationsResponse\x12c\n" +
+	"\x13SetMovieTranslation\x12*.movie_proto.v1.SetMovieTranslationRequest\x1a .movie_proto.v1.MovieTranslation\x12_\n" +
+	"\x16DeleteMovieTranslation\x12-.movie_proto.v1.DeleteMovieTranslationRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
+	"\x16SetMovieCertifications\x12-.movie_proto.v1.SetMovieCertificationsRequest\x1a\x15.movie_proto.v1.Movie\x12V\n" +
 	"\vListRatings\x12\".movie_proto.v1.ListRatingsRequest\x1a#.movie_proto.v1.ListRatingsResponse\x12E\n" +
 	"\tGetRating\x12 .movie_proto.v1.GetRatingRequest\x1a\x16.movie_proto.v1.Rating\x12Y\n" +
-	"\fCreateRating\x12#.movie_proto.v1.CreateRatingRequest\x1a$.movie_proto.v1.CreateRatingResponse\x12K\n" +
+	"\fCreateRating\x12#.movie_proto.v1.CreateRatingRequest\x1a$.movie_proto.v1.CreateRatingResponse\x12I\n" +
+	"\vGetMyRating\x12\".movie_proto.v1.GetMyRatingRequest\x1a\x16.movie_proto.v1.Rating\x12K\n" +
 	"\fDeleteRating\x12#.movie_proto.v1.DeleteRatingRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
 	"\fListComments\x12#.movie_proto.v1.ListCommentsRequest\x1a$.movie_proto.v1.ListCommentsResponse\x12H\n" +
 	"\n" +
 	"GetComment\x12!.movie_proto.v1.GetCommentRequest\x1a\x17.movie_proto.v1.Comment\x12\\\n" +
-	"\rCreateComment\x12$.movie_proto.v1.CreateCommentRequest\x1a%.movie_proto.v1.CreateCommentResponse\x12M\n" +
-	"\rDeleteComment\x12$.movie_proto.v1.DeleteCommentRequest\x1a\x16.google.protobuf.EmptyB\x03Z\x01/b\x06proto3"
+	"\rCreateComment\x12$.movie_proto.v1.CreateCommentRequest\x1a%.movie_proto.v1.CreateCommentResponse\x12J\n" +
+	"\vEditComment\x12\".movie_proto.v1.EditCommentRequest\x1a\x17.movie_proto.v1.Comment\x12M\n" +
+	"\rDeleteComment\x12$.movie_proto.v1.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\n" +
+	"ListGenres\x12!.movie_proto.v1.ListGenresRequest\x1a\".movie_proto.v1.ListGenresResponse\x12V\n" +
+	"\vCreateGenre\x12\".movie_proto.v1.CreateGenreRequest\x1a#.movie_proto.v1.CreateGenreResponse\x12V\n" +
+	"\vUpdateGenre\x12\".movie_proto.v1.UpdateGenreRequest\x1a#.movie_proto.v1.UpdateGenreResponse\x12I\n" +
+	"\vDeleteGenre\x12\".movie_proto.v1.DeleteGenreRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\n" +
+	"ListPeople\x12!.movie_proto.v1.ListPeopleRequest\x1a\".movie_proto.v1.ListPeopleResponse\x12E\n" +
+	"\tGetPerson\x12 .movie_proto.v1.GetPersonRequest\x1a\x16.movie_proto.v1.Person\x12Y\n" +
+	"\fCreatePerson\x12#.movie_proto.v1.CreatePersonRequest\x1a$.movie_proto.v1.CreatePersonResponse\x12Y\n" +
+	"\fUpdatePerson\x12#.movie_proto.v1.UpdatePersonRequest\x1a$.movie_proto.v1.UpdatePersonResponse\x12K\n" +
+	"\fDeletePerson\x12#.movie_proto.v1.DeletePersonRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x0fListFilmography\x12&.movie_proto.v1.ListFilmographyRequest\x1a'.movie_proto.v1.ListFilmographyResponse\x12P\n" +
+	"\x0fSetMovieCredits\x12&.movie_proto.v1.SetMovieCreditsRequest\x1a\x15.movie_proto.v1.Movie\x12S\n" +
+	"\n" +
+	"ListSeries\x12!.movie_proto.v1.ListSeriesRequest\x1a\".movie_proto.v1.ListSeriesResponse\x12E\n" +
+	"\tGetSeries\x12 .movie_proto.v1.GetSeriesRequest\x1a\x16.movie_proto.v1.Series\x12Y\n" +
+	"\fListEpisodes\x12#.movie_proto.v1.ListEpisodesRequest\x1a$.movie_proto.v1.ListEpisodesResponse\x12H\n" +
+	"\n" +
+	"GetEpisode\x12!.movie_proto.v1.GetEpisodeRequest\x1a\x17.movie_proto.v1.Episode\x12_\n" +
+	"\x0eGetNextEpisode\x12%.movie_proto.v1.GetNextEpisodeRequest\x1a&.movie_proto.v1.GetNextEpisodeResponseB\x03Z\x01/b\x06proto3"
 
 var (
 	file_pkg_proto_movie_proto_rawDescOnce sync.Once
@@ -1484,78 +5519,238 @@ func file_pkg_proto_movie_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_movie_proto_rawDescData
 }
 
-var file_pkg_proto_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_pkg_proto_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_pkg_proto_movie_proto_goTypes = []any{
-	(*Genre)(nil),                 // 0: movie_proto.v1.Genre
-	(*Movie)(nil),                 // 1: movie_proto.v1.Movie
-	(*Rating)(nil),                // 2: movie_proto.v1.Rating
-	(*Comment)(nil),               // 3: movie_proto.v1.Comment
-	(*ListMoviesRequest)(nil),     // 4: movie_proto.v1.ListMoviesRequest
-	(*ListMoviesResponse)(nil),    // 5: movie_proto.v1.ListMoviesResponse
-	(*GetMovieRequest)(nil),       // 6: movie_proto.v1.GetMovieRequest
-	(*CreateMovieRequest)(nil),    // 7: movie_proto.v1.CreateMovieRequest
-	(*CreateMovieResponse)(nil),   // 8: movie_proto.v1.CreateMovieResponse
-	(*DeleteMovieRequest)(nil),    // 9: movie_proto.v1.DeleteMovieRequest
-	(*ListRatingsRequest)(nil),    // 10: movie_proto.v1.ListRatingsRequest
-	(*ListRatingsResponse)(nil),   // 11: movie_proto.v1.ListRatingsResponse
-	(*GetRatingRequest)(nil),      // 12: movie_proto.v1.GetRatingRequest
-	(*CreateRatingRequest)(nil),   // 13: movie_proto.v1.CreateRatingRequest
-	(*CreateRatingResponse)(nil),  // 14: movie_proto.v1.CreateRatingResponse
-	(*DeleteRatingRequest)(nil),   // 15: movie_proto.v1.DeleteRatingRequest
-	(*ListCommentsRequest)(nil),   // 16: movie_proto.v1.ListCommentsRequest
-	(*ListCommentsResponse)(nil),  // 17: movie_proto.v1.ListCommentsResponse
-	(*GetCommentRequest)(nil),     // 18: movie_proto.v1.GetCommentRequest
-	(*CreateCommentRequest)(nil),  // 19: movie_proto.v1.CreateCommentRequest
-	(*CreateCommentResponse)(nil), // 20: movie_proto.v1.CreateCommentResponse
-	(*DeleteCommentRequest)(nil),  // 21: movie_proto.v1.DeleteCommentRequest
-	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 23: google.protobuf.Empty
+	(*Genre)(nil),                         // 0: movie_proto.v1.Genre
+	(*Movie)(nil),                         // 1: movie_proto.v1.Movie
+	(*Certification)(nil),                 // 2: movie_proto.v1.Certification
+	(*MovieTranslation)(nil),              // 3: movie_proto.v1.MovieTranslation
+	(*Person)(nil),                        // 4: movie_proto.v1.Person
+	(*Credit)(nil),                        // 5: movie_proto.v1.Credit
+	(*FilmographyEntry)(nil),              // 6: movie_proto.v1.FilmographyEntry
+	(*RatingStats)(nil),                   // 7: movie_proto.v1.RatingStats
+	(*Series)(nil),                        // 8: movie_proto.v1.Series
+	(*Season)(nil),                        // 9: movie_proto.v1.Season
+	(*Episode)(nil),                       // 10: movie_proto.v1.Episode
+	(*Rating)(nil),                        // 11: movie_proto.v1.Rating
+	(*Comment)(nil),                       // 12: movie_proto.v1.Comment
+	(*ListMoviesRequest)(nil),             // 13: movie_proto.v1.ListMoviesRequest
+	(*ListMoviesResponse)(nil),            // 14: movie_proto.v1.ListMoviesResponse
+	(*SearchMoviesRequest)(nil),           // 15: movie_proto.v1.SearchMoviesRequest
+	(*SearchMoviesResponse)(nil),          // 16: movie_proto.v1.SearchMoviesResponse
+	(*GetMovieRequest)(nil),               // 17: movie_proto.v1.GetMovieRequest
+	(*CreateMovieRequest)(nil),            // 18: movie_proto.v1.CreateMovieRequest
+	(*CreateMovieResponse)(nil),           // 19: movie_proto.v1.CreateMovieResponse
+	(*DeleteMovieRequest)(nil),            // 20: movie_proto.v1.DeleteMovieRequest
+	(*RestoreMovieRequest)(nil),           // 21: movie_proto.v1.RestoreMovieRequest
+	(*PurgeMovieRequest)(nil),             // 22: movie_proto.v1.PurgeMovieRequest
+	(*PublishMovieRequest)(nil),           // 23: movie_proto.v1.PublishMovieRequest
+	(*ScheduleMovieRequest)(nil),          // 24: movie_proto.v1.ScheduleMovieRequest
+	(*ArchiveMovieRequest)(nil),           // 25: movie_proto.v1.ArchiveMovieRequest
+	(*RevertMovieToDraftRequest)(nil),     // 26: movie_proto.v1.RevertMovieToDraftRequest
+	(*UpdateMovieRequest)(nil),            // 27: movie_proto.v1.UpdateMovieRequest
+	(*UpdateMovieResponse)(nil),           // 28: movie_proto.v1.UpdateMovieResponse
+	(*PatchMovieRequest)(nil),             // 29: movie_proto.v1.PatchMovieRequest
+	(*ListMovieTranslationsRequest)(nil),  // 30: movie_proto.v1.ListMovieTranslationsRequest
+	(*ListMovieTranslationsResponse)(nil), // 31: movie_proto.v1.ListMovieTranslationsResponse
+	(*SetMovieTranslationRequest)(nil),    // 32: movie_proto.v1.SetMovieTranslationRequest
+	(*DeleteMovieTranslationRequest)(nil), // 33: movie_proto.v1.DeleteMovieTranslationRequest
+	(*SetMovieCertificationsRequest)(nil), // 34: movie_proto.v1.SetMovieCertificationsRequest
+	(*ListRatingsRequest)(nil),            // 35: movie_proto.v1.ListRatingsRequest
+	(*ListRatingsResponse)(nil),           // 36: movie_proto.v1.ListRatingsResponse
+	(*GetRatingRequest)(nil),              // 37: movie_proto.v1.GetRatingRequest
+	(*CreateRatingRequest)(nil),           // 38: movie_proto.v1.CreateRatingRequest
+	(*CreateRatingResponse)(nil),          // 39: movie_proto.v1.CreateRatingResponse
+	(*GetMyRatingRequest)(nil),            // 40: movie_proto.v1.GetMyRatingRequest
+	(*DeleteRatingRequest)(nil),           // 41: movie_proto.v1.DeleteRatingRequest
+	(*ListCommentsRequest)(nil),           // 42: movie_proto.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 43: movie_proto.v1.ListCommentsResponse
+	(*GetCommentRequest)(nil),             // 44: movie_proto.v1.GetCommentRequest
+	(*CreateCommentRequest)(nil),          // 45: movie_proto.v1.CreateCommentRequest
+	(*CreateCommentResponse)(nil),         // 46: movie_proto.v1.CreateCommentResponse
+	(*EditCommentRequest)(nil),            // 47: movie_proto.v1.EditCommentRequest
+	(*DeleteCommentRequest)(nil),          // 48: movie_proto.v1.DeleteCommentRequest
+	(*ListGenresRequest)(nil),             // 49: movie_proto.v1.ListGenresRequest
+	(*ListGenresResponse)(nil),            // 50: movie_proto.v1.ListGenresResponse
+	(*CreateGenreRequest)(nil),            // 51: movie_proto.v1.CreateGenreRequest
+	(*CreateGenreResponse)(nil),           // 52: movie_proto.v1.CreateGenreResponse
+	(*UpdateGenreRequest)(nil),            // 53: movie_proto.v1.UpdateGenreRequest
+	(*UpdateGenreResponse)(nil),           // 54: movie_proto.v1.UpdateGenreResponse
+	(*DeleteGenreRequest)(nil),            // 55: movie_proto.v1.DeleteGenreRequest
+	(*ListPeopleRequest)(nil),             // 56: movie_proto.v1.ListPeopleRequest
+	(*ListPeopleResponse)(nil),            // 57: movie_proto.v1.ListPeopleResponse
+	(*GetPersonRequest)(nil),              // 58: movie_proto.v1.GetPersonRequest
+	(*CreatePersonRequest)(nil),           // 59: movie_proto.v1.CreatePersonRequest
+	(*CreatePersonResponse)(nil),          // 60: movie_proto.v1.CreatePersonResponse
+	(*UpdatePersonRequest)(nil),           // 61: movie_proto.v1.UpdatePersonRequest
+	(*UpdatePersonResponse)(nil),          // 62: movie_proto.v1.UpdatePersonResponse
+	(*DeletePersonRequest)(nil),           // 63: movie_proto.v1.DeletePersonRequest
+	(*ListFilmographyRequest)(nil),        // 64: movie_proto.v1.ListFilmographyRequest
+	(*ListFilmographyResponse)(nil),       // 65: movie_proto.v1.ListFilmographyResponse
+	(*SetMovieCreditsRequest)(nil),        // 66: movie_proto.v1.SetMovieCreditsRequest
+	(*ListSeriesRequest)(nil),             // 67: movie_proto.v1.ListSeriesRequest
+	(*ListSeriesResponse)(nil),            // 68: movie_proto.v1.ListSeriesResponse
+	(*GetSeriesRequest)(nil),              // 69: movie_proto.v1.GetSeriesRequest
+	(*ListEpisodesRequest)(nil),           // 70: movie_proto.v1.ListEpisodesRequest
+	(*ListEpisodesResponse)(nil),          // 71: movie_proto.v1.ListEpisodesResponse
+	(*GetEpisodeRequest)(nil),             // 72: movie_proto.v1.GetEpisodeRequest
+	(*GetNextEpisodeRequest)(nil),         // 73: movie_proto.v1.GetNextEpisodeRequest
+	(*GetNextEpisodeResponse)(nil),        // 74: movie_proto.v1.GetNextEpisodeResponse
+	(*timestamppb.Timestamp)(nil),         // 75: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 76: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 77: google.protobuf.Empty
 }
 var file_pkg_proto_movie_proto_depIdxs = []int32{
-	22, // 0: movie_proto.v1.Movie.release_date:type_name -> google.protobuf.Timestamp
-	0,  // 1: movie_proto.v1.Movie.genres:type_name -> movie_proto.v1.Genre
-	22, // 2: movie_proto.v1.Movie.created_at:type_name -> google.protobuf.Timestamp
-	22, // 3: movie_proto.v1.Movie.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 4: movie_proto.v1.Rating.created_at:type_name -> google.protobuf.Timestamp
-	22, // 5: movie_proto.v1.Rating.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 6: movie_proto.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
-	22, // 7: movie_proto.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 8: movie_proto.v1.ListMoviesResponse.movies:type_name -> movie_proto.v1.Movie
-	22, // 9: movie_proto.v1.CreateMovieRequest.release_date:type_name -> google.protobuf.Timestamp
-	1,  // 10: movie_proto.v1.CreateMovieResponse.movie:type_name -> movie_proto.v1.Movie
-	2,  // 11: movie_proto.v1.ListRatingsResponse.ratings:type_name -> movie_proto.v1.Rating
-	2,  // 12: movie_proto.v1.CreateRatingResponse.rating:type_name -> movie_proto.v1.Rating
-	3,  // 13: movie_proto.v1.ListCommentsResponse.comments:type_name -> movie_proto.v1.Comment
-	3,  // 14: movie_proto.v1.CreateCommentResponse.comment:type_name -> movie_proto.v1.Comment
-	4,  // 15: movie_proto.v1.MovieService.ListMovies:input_type -> movie_proto.v1.ListMoviesRequest
-	6,  // 16: movie_proto.v1.MovieService.GetMovie:input_type -> movie_proto.v1.GetMovieRequest
-	7,  // 17: movie_proto.v1.MovieService.CreateMovie:input_type -> movie_proto.v1.CreateMovieRequest
-	9,  // 18: movie_proto.v1.MovieService.DeleteMovie:input_type -> movie_proto.v1.DeleteMovieRequest
-	10, // 19: movie_proto.v1.MovieService.ListRatings:input_type -> movie_proto.v1.ListRatingsRequest
-	12, // 20: movie_proto.v1.MovieService.GetRating:input_type -> movie_proto.v1.GetRatingRequest
-	13, // 21: movie_proto.v1.MovieService.CreateRating:input_type -> movie_proto.v1.CreateRatingRequest
-	15, // 22: movie_proto.v1.MovieService.DeleteRating:input_type -> movie_proto.v1.DeleteRatingRequest
-	16, // 23: movie_proto.v1.MovieService.ListComments:input_type -> movie_proto.v1.ListCommentsRequest
-	18, // 24: movie_proto.v1.MovieService.GetComment:input_type -> movie_proto.v1.GetCommentRequest
-	19, // 25: movie_proto.v1.MovieService.CreateComment:input_type -> movie_proto.v1.CreateCommentRequest
-	21, // 26: movie_proto.v1.MovieService.DeleteComment:input_type -> movie_proto.v1.DeleteCommentRequest
-	5,  // 27: movie_proto.v1.MovieService.ListMovies:output_type -> movie_proto.v1.ListMoviesResponse
-	1,  // 28: movie_proto.v1.MovieService.GetMovie:output_type -> movie_proto.v1.Movie
-	8,  // 29: movie_proto.v1.MovieService.CreateMovie:output_type -> movie_proto.v1.CreateMovieResponse
-	23, // 30: movie_proto.v1.MovieService.DeleteMovie:output_type -> google.protobuf.Empty
-	11, // 31: movie_proto.v1.MovieService.ListRatings:output_type -> movie_proto.v1.ListRatingsResponse
-	2,  // 32: movie_proto.v1.MovieService.GetRating:output_type -> movie_proto.v1.Rating
-	14, // 33: movie_proto.v1.MovieService.CreateRating:output_type -> movie_proto.v1.CreateRatingResponse
-	23, // 34: movie_proto.v1.MovieService.DeleteRating:output_type -> google.protobuf.Empty
-	17, // 35: movie_proto.v1.MovieService.ListComments:output_type -> movie_proto.v1.ListCommentsResponse
-	3,  // 36: movie_proto.v1.MovieService.GetComment:output_type -> movie_proto.v1.Comment
-	20, // 37: movie_proto.v1.MovieService.CreateComment:output_type -> movie_proto.v1.CreateCommentResponse
-	23, // 38: movie_proto.v1.MovieService.DeleteComment:output_type -> google.protobuf.Empty
-	27, // [27:39] is the sub-list for method output_type
-	15, // [15:27] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	75,  // 0: movie_proto.v1.Movie.release_date:type_name -> google.protobuf.Timestamp
+	0,   // 1: movie_proto.v1.Movie.genres:type_name -> movie_proto.v1.Genre
+	75,  // 2: movie_proto.v1.Movie.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 3: movie_proto.v1.Movie.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 4: movie_proto.v1.Movie.rating_stats:type_name -> movie_proto.v1.RatingStats
+	5,   // 5: movie_proto.v1.Movie.credits:type_name -> movie_proto.v1.Credit
+	75,  // 6: movie_proto.v1.Movie.publish_at:type_name -> google.protobuf.Timestamp
+	75,  // 7: movie_proto.v1.Movie.unpublish_at:type_name -> google.protobuf.Timestamp
+	2,   // 8: movie_proto.v1.Movie.certifications:type_name -> movie_proto.v1.Certification
+	75,  // 9: movie_proto.v1.MovieTranslation.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 10: movie_proto.v1.MovieTranslation.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 11: movie_proto.v1.Person.birth_date:type_name -> google.protobuf.Timestamp
+	75,  // 12: movie_proto.v1.Person.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 13: movie_proto.v1.Person.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 14: movie_proto.v1.FilmographyEntry.release_date:type_name -> google.protobuf.Timestamp
+	75,  // 15: movie_proto.v1.Series.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 16: movie_proto.v1.Series.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 17: movie_proto.v1.Series.rating_stats:type_name -> movie_proto.v1.RatingStats
+	9,   // 18: movie_proto.v1.Series.seasons:type_name -> movie_proto.v1.Season
+	75,  // 19: movie_proto.v1.Episode.release_date:type_name -> google.protobuf.Timestamp
+	75,  // 20: movie_proto.v1.Episode.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 21: movie_proto.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 22: movie_proto.v1.Episode.rating_stats:type_name -> movie_proto.v1.RatingStats
+	75,  // 23: movie_proto.v1.Rating.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 24: movie_proto.v1.Rating.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 25: movie_proto.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 26: movie_proto.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 27: movie_proto.v1.Comment.replies:type_name -> movie_proto.v1.Comment
+	1,   // 28: movie_proto.v1.ListMoviesResponse.movies:type_name -> movie_proto.v1.Movie
+	1,   // 29: movie_proto.v1.SearchMoviesResponse.movies:type_name -> movie_proto.v1.Movie
+	75,  // 30: movie_proto.v1.CreateMovieRequest.release_date:type_name -> google.protobuf.Timestamp
+	1,   // 31: movie_proto.v1.CreateMovieResponse.movie:type_name -> movie_proto.v1.Movie
+	75,  // 32: movie_proto.v1.PublishMovieRequest.unpublish_at:type_name -> google.protobuf.Timestamp
+	75,  // 33: movie_proto.v1.ScheduleMovieRequest.publish_at:type_name -> google.protobuf.Timestamp
+	75,  // 34: movie_proto.v1.ScheduleMovieRequest.unpublish_at:type_name -> google.protobuf.Timestamp
+	75,  // 35: movie_proto.v1.UpdateMovieRequest.release_date:type_name -> google.protobuf.Timestamp
+	1,   // 36: movie_proto.v1.UpdateMovieResponse.movie:type_name -> movie_proto.v1.Movie
+	75,  // 37: movie_proto.v1.PatchMovieRequest.release_date:type_name -> google.protobuf.Timestamp
+	76,  // 38: movie_proto.v1.PatchMovieRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,   // 39: movie_proto.v1.ListMovieTranslationsResponse.translations:type_name -> movie_proto.v1.MovieTranslation
+	2,   // 40: movie_proto.v1.SetMovieCertificationsRequest.certifications:type_name -> movie_proto.v1.Certification
+	11,  // 41: movie_proto.v1.ListRatingsResponse.ratings:type_name -> movie_proto.v1.Rating
+	11,  // 42: movie_proto.v1.CreateRatingResponse.rating:type_name -> movie_proto.v1.Rating
+	12,  // 43: movie_proto.v1.ListCommentsResponse.comments:type_name -> movie_proto.v1.Comment
+	12,  // 44: movie_proto.v1.CreateCommentResponse.comment:type_name -> movie_proto.v1.Comment
+	0,   // 45: movie_proto.v1.ListGenresResponse.genres:type_name -> movie_proto.v1.Genre
+	0,   // 46: movie_proto.v1.CreateGenreResponse.genre:type_name -> movie_proto.v1.Genre
+	0,   // 47: movie_proto.v1.UpdateGenreResponse.genre:type_name -> movie_proto.v1.Genre
+	4,   // 48: movie_proto.v1.ListPeopleResponse.people:type_name -> movie_proto.v1.Person
+	75,  // 49: movie_proto.v1.CreatePersonRequest.birth_date:type_name -> google.protobuf.Timestamp
+	4,   // 50: movie_proto.v1.CreatePersonResponse.person:type_name -> movie_proto.v1.Person
+	75,  // 51: movie_proto.v1.UpdatePersonRequest.birth_date:type_name -> google.protobuf.Timestamp
+	4,   // 52: movie_proto.v1.UpdatePersonResponse.person:type_name -> movie_proto.v1.Person
+	4,   // 53: movie_proto.v1.ListFilmographyResponse.person:type_name -> movie_proto.v1.Person
+	6,   // 54: movie_proto.v1.ListFilmographyResponse.entries:type_name -> movie_proto.v1.FilmographyEntry
+	5,   // 55: movie_proto.v1.SetMovieCreditsRequest.credits:type_name -> movie_proto.v1.Credit
+	8,   // 56: movie_proto.v1.ListSeriesResponse.series:type_name -> movie_proto.v1.Series
+	10,  // 57: movie_proto.v1.ListEpisodesResponse.episodes:type_name -> movie_proto.v1.Episode
+	10,  // 58: movie_proto.v1.GetNextEpisodeResponse.episode:type_name -> movie_proto.v1.Episode
+	13,  // 59: movie_proto.v1.MovieService.ListMovies:input_type -> movie_proto.v1.ListMoviesRequest
+	17,  // 60: movie_proto.v1.MovieService.GetMovie:input_type -> movie_proto.v1.GetMovieRequest
+	18,  // 61: movie_proto.v1.MovieService.CreateMovie:input_type -> movie_proto.v1.CreateMovieRequest
+	20,  // 62: movie_proto.v1.MovieService.DeleteMovie:input_type -> movie_proto.v1.DeleteMovieRequest
+	21,  // 63: movie_proto.v1.MovieService.RestoreMovie:input_type -> movie_proto.v1.RestoreMovieRequest
+	22,  // 64: movie_proto.v1.MovieService.PurgeMovie:input_type -> movie_proto.v1.PurgeMovieRequest
+	23,  // 65: movie_proto.v1.MovieService.PublishMovie:input_type -> movie_proto.v1.PublishMovieRequest
+	24,  // 66: movie_proto.v1.MovieService.ScheduleMovie:input_type -> movie_proto.v1.ScheduleMovieRequest
+	25,  // 67: movie_proto.v1.MovieService.ArchiveMovie:input_type -> movie_proto.v1.ArchiveMovieRequest
+	26,  // 68: movie_proto.v1.MovieService.RevertMovieToDraft:input_type -> movie_proto.v1.RevertMovieToDraftRequest
+	27,  // 69: movie_proto.v1.MovieService.UpdateMovie:input_type -> movie_proto.v1.UpdateMovieRequest
+	29,  // 70: movie_proto.v1.MovieService.PatchMovie:input_type -> movie_proto.v1.PatchMovieRequest
+	15,  // 71: movie_proto.v1.MovieService.SearchMovies:input_type -> movie_proto.v1.SearchMoviesRequest
+	30,  // 72: movie_proto.v1.MovieService.ListMovieTranslations:input_type -> movie_proto.v1.ListMovieTranslationsRequest
+	32,  // 73: movie_proto.v1.MovieService.SetMovieTranslation:input_type -> movie_proto.v1.SetMovieTranslationRequest
+	33,  // 74: movie_proto.v1.MovieService.DeleteMovieTranslation:input_type -> movie_proto.v1.DeleteMovieTranslationRequest
+	34,  // 75: movie_proto.v1.MovieService.SetMovieCertifications:input_type -> movie_proto.v1.SetMovieCertificationsRequest
+	35,  // 76: movie_proto.v1.MovieService.ListRatings:input_type -> movie_proto.v1.ListRatingsRequest
+	37,  // 77: movie_proto.v1.MovieService.GetRating:input_type -> movie_proto.v1.GetRatingRequest
+	38,  // 78: movie_proto.v1.MovieService.CreateRating:input_type -> movie_proto.v1.CreateRatingRequest
+	40,  // 79: movie_proto.v1.MovieService.GetMyRating:input_type -> movie_proto.v1.GetMyRatingRequest
+	41,  // 80: movie_proto.v1.MovieService.DeleteRating:input_type -> movie_proto.v1.DeleteRatingRequest
+	42,  // 81: movie_proto.v1.MovieService.ListComments:input_type -> movie_proto.v1.ListCommentsRequest
+	44,  // 82: movie_proto.v1.MovieService.GetComment:input_type -> movie_proto.v1.GetCommentRequest
+	45,  // 83: movie_proto.v1.MovieService.CreateComment:input_type -> movie_proto.v1.CreateCommentRequest
+	47,  // 84: movie_proto.v1.MovieService.EditComment:input_type -> movie_proto.v1.EditCommentRequest
+	48,  // 85: movie_proto.v1.MovieService.DeleteComment:input_type -> movie_proto.v1.DeleteCommentRequest
+	49,  // 86: movie_proto.v1.MovieService.ListGenres:input_type -> movie_proto.v1.ListGenresRequest
+	51,  // 87: movie_proto.v1.MovieService.CreateGenre:input_type -> movie_proto.v1.CreateGenreRequest
+	53,  // 88: movie_proto.v1.MovieService.UpdateGenre:input_type -> movie_proto.v1.UpdateGenreRequest
+	55,  // 89: movie_proto.v1.MovieService.DeleteGenre:input_type -> movie_proto.v1.DeleteGenreRequest
+	56,  // 90: movie_proto.v1.MovieService.ListPeople:input_type -> movie_proto.v1.ListPeopleRequest
+	58,  // 91: movie_proto.v1.MovieService.GetPerson:input_type -> movie_proto.v1.GetPersonRequest
+	59,  // 92: movie_proto.v1.MovieService.CreatePerson:input_type -> movie_proto.v1.CreatePersonRequest
+	61,  // 93: movie_proto.v1.MovieService.UpdatePerson:input_type -> movie_proto.v1.UpdatePersonRequest
+	63,  // 94: movie_proto.v1.MovieService.DeletePerson:input_type -> movie_proto.v1.DeletePersonRequest
+	64,  // 95: movie_proto.v1.MovieService.ListFilmography:input_type -> movie_proto.v1.ListFilmographyRequest
+	66,  // 96: movie_proto.v1.MovieService.SetMovieCredits:input_type -> movie_proto.v1.SetMovieCreditsRequest
+	67,  // 97: movie_proto.v1.MovieService.ListSeries:input_type -> movie_proto.v1.ListSeriesRequest
+	69,  // 98: movie_proto.v1.MovieService.GetSeries:input_type -> movie_proto.v1.GetSeriesRequest
+	70,  // 99: movie_proto.v1.MovieService.ListEpisodes:input_type -> movie_proto.v1.ListEpisodesRequest
+	72,  // 100: movie_proto.v1.MovieService.GetEpisode:input_type -> movie_proto.v1.GetEpisodeRequest
+	73,  // 101: movie_proto.v1.MovieService.GetNextEpisode:input_type -> movie_proto.v1.GetNextEpisodeRequest
+	14,  // 102: movie_proto.v1.MovieService.ListMovies:output_type -> movie_proto.v1.ListMoviesResponse
+	1,   // 103: movie_proto.v1.MovieService.GetMovie:output_type -> movie_proto.v1.Movie
+	19,  // 104: movie_proto.v1.MovieService.CreateMovie:output_type -> movie_proto.v1.CreateMovieResponse
+	77,  // 105: movie_proto.v1.MovieService.DeleteMovie:output_type -> google.protobuf.Empty
+	1,   // 106: movie_proto.v1.MovieService.RestoreMovie:output_type -> movie_proto.v1.Movie
+	77,  // 107: movie_proto.v1.MovieService.PurgeMovie:output_type -> google.protobuf.Empty
+	1,   // 108: movie_proto.v1.MovieService.PublishMovie:output_type -> movie_proto.v1.Movie
+	1,   // 109: movie_proto.v1.MovieService.ScheduleMovie:output_type -> movie_proto.v1.Movie
+	1,   // 110: movie_proto.v1.MovieService.ArchiveMovie:output_type -> movie_proto.v1.Movie
+	1,   // 111: movie_proto.v1.MovieService.RevertMovieToDraft:output_type -> movie_proto.v1.Movie
+	28,  // 112: movie_proto.v1.MovieService.UpdateMovie:output_type -> movie_proto.v1.UpdateMovieResponse
+	28,  // 113: movie_proto.v1.MovieService.PatchMovie:output_type -> movie_proto.v1.UpdateMovieResponse
+	16,  // 114: movie_proto.v1.MovieService.SearchMovies:output_type -> movie_proto.v1.SearchMoviesResponse
+	31,  // 115: movie_proto.v1.MovieService.ListMovieTranslations:output_type -> movie_proto.v1.ListMovieTranslationsResponse
+	3,   // 116: movie_proto.v1.MovieService.SetMovieTranslation:output_type -> movie_proto.v1.MovieTranslation
+	77,  // 117: movie_proto.v1.MovieService.DeleteMovieTranslation:output_type -> google.protobuf.Empty
+	1,   // 118: movie_proto.v1.MovieService.SetMovieCertifications:output_type -> movie_proto.v1.Movie
+	36,  // 119: movie_proto.v1.MovieService.ListRatings:output_type -> movie_proto.v1.ListRatingsResponse
+	11,  // 120: movie_proto.v1.MovieService.GetRating:output_type -> movie_proto.v1.Rating
+	39,  // 121: movie_proto.v1.MovieService.CreateRating:output_type -> movie_proto.v1.CreateRatingResponse
+	11,  // 122: movie_proto.v1.MovieService.GetMyRating:output_type -> movie_proto.v1.Rating
+	77,  // 123: movie_proto.v1.MovieService.DeleteRating:output_type -> google.protobuf.Empty
+	43,  // 124: movie_proto.v1.MovieService.ListComments:output_type -> movie_proto.v1.ListCommentsResponse
+	12,  // 125: movie_proto.v1.MovieService.GetComment:output_type -> movie_proto.v1.Comment
+	46,  // 126: movie_proto.v1.MovieService.CreateComment:output_type -> movie_proto.v1.CreateCommentResponse
+	12,  // 127: movie_proto.v1.MovieService.EditComment:output_type -> movie_proto.v1.Comment
+	77,  // 128: movie_proto.v1.MovieService.DeleteComment:output_type -> google.protobuf.Empty
+	50,  // 129: movie_proto.v1.MovieService.ListGenres:output_type -> movie_proto.v1.ListGenresResponse
+	52,  // 130: movie_proto.v1.MovieService.CreateGenre:output_type -> movie_proto.v1.CreateGenreResponse
+	54,  // 131: movie_proto.v1.MovieService.UpdateGenre:output_type -> movie_proto.v1.UpdateGenreResponse
+	77,  // 132: movie_proto.v1.MovieService.DeleteGenre:output_type -> google.protobuf.Empty
+	57,  // 133: movie_proto.v1.MovieService.ListPeople:output_type -> movie_proto.v1.ListPeopleResponse
+	4,   // 134: movie_proto.v1.MovieService.GetPerson:output_type -> movie_proto.v1.Person
+	60,  // 135: movie_proto.v1.MovieService.CreatePerson:output_type -> movie_proto.v1.CreatePersonResponse
+	62,  // 136: movie_proto.v1.MovieService.UpdatePerson:output_type -> movie_proto.v1.UpdatePersonResponse
+	77,  // 137: movie_proto.v1.MovieService.DeletePerson:output_type -> google.protobuf.Empty
+	65,  // 138: movie_proto.v1.MovieService.ListFilmography:output_type -> movie_proto.v1.ListFilmographyResponse
+	1,   // 139: movie_proto.v1.MovieService.SetMovieCredits:output_type -> movie_proto.v1.Movie
+	68,  // 140: movie_proto.v1.MovieService.ListSeries:output_type -> movie_proto.v1.ListSeriesResponse
+	8,   // 141: movie_proto.v1.MovieService.GetSeries:output_type -> movie_proto.v1.Series
+	71,  // 142: movie_proto.v1.MovieService.ListEpisodes:output_type -> movie_proto.v1.ListEpisodesResponse
+	10,  // 143: movie_proto.v1.MovieService.GetEpisode:output_type -> movie_proto.v1.Episode
+	74,  // 144: movie_proto.v1.MovieService.GetNextEpisode:output_type -> movie_proto.v1.GetNextEpisodeResponse
+	102, // [102:145] is the sub-list for method output_type
+	59,  // [59:102] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_movie_proto_init() }
@@ -1563,13 +5758,15 @@ func file_pkg_proto_movie_proto_init() {
 	if File_pkg_proto_movie_proto != nil {
 		return
 	}
+	file_pkg_proto_movie_proto_msgTypes[13].OneofWrappers = []any{}
+	file_pkg_proto_movie_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_movie_proto_rawDesc), len(file_pkg_proto_movie_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
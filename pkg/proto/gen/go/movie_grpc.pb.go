@@ -20,18 +20,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MovieService_ListMovies_FullMethodName    = "/movie_proto.v1.MovieService/ListMovies"
-	MovieService_GetMovie_FullMethodName      = "/movie_proto.v1.MovieService/GetMovie"
-	MovieService_CreateMovie_FullMethodName   = "/movie_proto.v1.MovieService/CreateMovie"
-	MovieService_DeleteMovie_FullMethodName   = "/movie_proto.v1.MovieService/DeleteMovie"
-	MovieService_ListRatings_FullMethodName   = "/movie_proto.v1.MovieService/ListRatings"
-	MovieService_GetRating_FullMethodName     = "/movie_proto.v1.MovieService/GetRating"
-	MovieService_CreateRating_FullMethodName  = "/movie_proto.v1.MovieService/CreateRating"
-	MovieService_DeleteRating_FullMethodName  = "/movie_proto.v1.MovieService/DeleteRating"
-	MovieService_ListComments_FullMethodName  = "/movie_proto.v1.MovieService/ListComments"
-	MovieService_GetComment_FullMethodName    = "/movie_proto.v1.MovieService/GetComment"
-	MovieService_CreateComment_FullMethodName = "/movie_proto.v1.MovieService/CreateComment"
-	MovieService_DeleteComment_FullMethodName = "/movie_proto.v1.MovieService/DeleteComment"
+	MovieService_ListMovies_FullMethodName             = "/movie_proto.v1.MovieService/ListMovies"
+	MovieService_GetMovie_FullMethodName               = "/movie_proto.v1.MovieService/GetMovie"
+	MovieService_CreateMovie_FullMethodName            = "/movie_proto.v1.MovieService/CreateMovie"
+	MovieService_DeleteMovie_FullMethodName            = "/movie_proto.v1.MovieService/DeleteMovie"
+	MovieService_RestoreMovie_FullMethodName           = "/movie_proto.v1.MovieService/RestoreMovie"
+	MovieService_PurgeMovie_FullMethodName             = "/movie_proto.v1.MovieService/PurgeMovie"
+	MovieService_PublishMovie_FullMethodName           = "/movie_proto.v1.MovieService/PublishMovie"
+	MovieService_ScheduleMovie_FullMethodName          = "/movie_proto.v1.MovieService/ScheduleMovie"
+	MovieService_ArchiveMovie_FullMethodName           = "/movie_proto.v1.MovieService/ArchiveMovie"
+	MovieService_RevertMovieToDraft_FullMethodName     = "/movie_proto.v1.MovieService/RevertMovieToDraft"
+	MovieService_UpdateMovie_FullMethodName            = "/movie_proto.v1.MovieService/UpdateMovie"
+	MovieService_PatchMovie_FullMethodName             = "/movie_proto.v1.MovieService/PatchMovie"
+	MovieService_SearchMovies_FullMethodName           = "/movie_proto.v1.MovieService/SearchMovies"
+	MovieService_ListMovieTranslations_FullMethodName  = "/movie_proto.v1.MovieService/ListMovieTranslations"
+	MovieService_SetMovieTranslation_FullMethodName    = "/movie_proto.v1.MovieService/SetMovieTranslation"
+	MovieService_DeleteMovieTranslation_FullMethodName = "/movie_proto.v1.MovieService/DeleteMovieTranslation"
+	MovieService_SetMovieCertifications_FullMethodName = "/movie_proto.v1.MovieService/SetMovieCertifications"
+	MovieService_ListRatings_FullMethodName            = "/movie_proto.v1.MovieService/ListRatings"
+	MovieService_GetRating_FullMethodName              = "/movie_proto.v1.MovieService/GetRating"
+	MovieService_CreateRating_FullMethodName           = "/movie_proto.v1.MovieService/CreateRating"
+	MovieService_GetMyRating_FullMethodName            = "/movie_proto.v1.MovieService/GetMyRating"
+	MovieService_DeleteRating_FullMethodName           = "/movie_proto.v1.MovieService/DeleteRating"
+	MovieService_ListComments_FullMethodName           = "/movie_proto.v1.MovieService/ListComments"
+	MovieService_GetComment_FullMethodName             = "/movie_proto.v1.MovieService/GetComment"
+	MovieService_CreateComment_FullMethodName          = "/movie_proto.v1.MovieService/CreateComment"
+	MovieService_EditComment_FullMethodName            = "/movie_proto.v1.MovieService/EditComment"
+	MovieService_DeleteComment_FullMethodName          = "/movie_proto.v1.MovieService/DeleteComment"
+	MovieService_ListGenres_FullMethodName             = "/movie_proto.v1.MovieService/ListGenres"
+	MovieService_CreateGenre_FullMethodName            = "/movie_proto.v1.MovieService/CreateGenre"
+	MovieService_UpdateGenre_FullMethodName            = "/movie_proto.v1.MovieService/UpdateGenre"
+	MovieService_DeleteGenre_FullMethodName            = "/movie_proto.v1.MovieService/DeleteGenre"
+	MovieService_ListPeople_FullMethodName             = "/movie_proto.v1.MovieService/ListPeople"
+	MovieService_GetPerson_FullMethodName              = "/movie_proto.v1.MovieService/GetPerson"
+	MovieService_CreatePerson_FullMethodName           = "/movie_proto.v1.MovieService/CreatePerson"
+	MovieService_UpdatePerson_FullMethodName           = "/movie_proto.v1.MovieService/UpdatePerson"
+	MovieService_DeletePerson_FullMethodName           = "/movie_proto.v1.MovieService/DeletePerson"
+	MovieService_ListFilmography_FullMethodName        = "/movie_proto.v1.MovieService/ListFilmography"
+	MovieService_SetMovieCredits_FullMethodName        = "/movie_proto.v1.MovieService/SetMovieCredits"
+	MovieService_ListSeries_FullMethodName             = "/movie_proto.v1.MovieService/ListSeries"
+	MovieService_GetSeries_FullMethodName              = "/movie_proto.v1.MovieService/GetSeries"
+	MovieService_ListEpisodes_FullMethodName           = "/movie_proto.v1.MovieService/ListEpisodes"
+	MovieService_GetEpisode_FullMethodName             = "/movie_proto.v1.MovieService/GetEpisode"
+	MovieService_GetNextEpisode_FullMethodName         = "/movie_proto.v1.MovieService/GetNextEpisode"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -48,16 +79,50 @@ type MovieServiceClient interface {
 	GetMovie(ctx context.Context, in *GetMovieRequest, opts ...grpc.CallOption) (*Movie, error)
 	CreateMovie(ctx context.Context, in *CreateMovieRequest, opts ...grpc.CallOption) (*CreateMovieResponse, error)
 	DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreMovie(ctx context.Context, in *RestoreMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	PurgeMovie(ctx context.Context, in *PurgeMovieRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishMovie(ctx context.Context, in *PublishMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	ScheduleMovie(ctx context.Context, in *ScheduleMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	ArchiveMovie(ctx context.Context, in *ArchiveMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	RevertMovieToDraft(ctx context.Context, in *RevertMovieToDraftRequest, opts ...grpc.CallOption) (*Movie, error)
+	UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error)
+	PatchMovie(ctx context.Context, in *PatchMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error)
+	SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error)
+	ListMovieTranslations(ctx context.Context, in *ListMovieTranslationsRequest, opts ...grpc.CallOption) (*ListMovieTranslationsResponse, error)
+	SetMovieTranslation(ctx context.Context, in *SetMovieTranslationRequest, opts ...grpc.CallOption) (*MovieTranslation, error)
+	DeleteMovieTranslation(ctx context.Context, in *DeleteMovieTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetMovieCertifications(ctx context.Context, in *SetMovieCertificationsRequest, opts ...grpc.CallOption) (*Movie, error)
 	// Работа с рейтингами
 	ListRatings(ctx context.Context, in *ListRatingsRequest, opts ...grpc.CallOption) (*ListRatingsResponse, error)
 	GetRating(ctx context.Context, in *GetRatingRequest, opts ...grpc.CallOption) (*Rating, error)
 	CreateRating(ctx context.Context, in *CreateRatingRequest, opts ...grpc.CallOption) (*CreateRatingResponse, error)
+	GetMyRating(ctx context.Context, in *GetMyRatingRequest, opts ...grpc.CallOption) (*Rating, error)
 	DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Работа с комментариями
 	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Работа с жанрами
+	ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error)
+	CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error)
+	UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*UpdateGenreResponse, error)
+	DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Работа с персонами и титрами
+	ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error)
+	GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*Person, error)
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error)
+	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error)
+	DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFilmography(ctx context.Context, in *ListFilmographyRequest, opts ...grpc.CallOption) (*ListFilmographyResponse, error)
+	SetMovieCredits(ctx context.Context, in *SetMovieCreditsRequest, opts ...grpc.CallOption) (*Movie, error)
+	// Работа с сериалами
+	ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error)
+	GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
+	GetNextEpisode(ctx context.Context, in *GetNextEpisodeRequest, opts ...grpc.CallOption) (*GetNextEpisodeResponse, error)
 }
 
 type movieServiceClient struct {
@@ -108,6 +173,136 @@ func (c *movieServiceClient) DeleteMovie(ctx context.Context, in *DeleteMovieReq
 	return out, nil
 }
 
+func (c *movieServiceClient) RestoreMovie(ctx context.Context, in *RestoreMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_RestoreMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PurgeMovie(ctx context.Context, in *PurgeMovieRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MovieService_PurgeMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PublishMovie(ctx context.Context, in *PublishMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_PublishMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ScheduleMovie(ctx context.Context, in *ScheduleMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_ScheduleMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ArchiveMovie(ctx context.Context, in *ArchiveMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_ArchiveMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) RevertMovieToDraft(ctx context.Context, in *RevertMovieToDraftRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_RevertMovieToDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_UpdateMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PatchMovie(ctx context.Context, in *PatchMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_PatchMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_SearchMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListMovieTranslations(ctx context.Context, in *ListMovieTranslationsRequest, opts ...grpc.CallOption) (*ListMovieTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMovieTranslationsResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListMovieTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieTranslation(ctx context.Context, in *SetMovieTranslationRequest, opts ...grpc.CallOption) (*MovieTranslation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieTranslation)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMovieTranslation(ctx context.Context, in *DeleteMovieTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMovieTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieCertifications(ctx context.Context, in *SetMovieCertificationsRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieCertifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) ListRatings(ctx context.Context, in *ListRatingsRequest, opts ...grpc.CallOption) (*ListRatingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRatingsResponse)
@@ -138,6 +333,16 @@ func (c *movieServiceClient) CreateRating(ctx context.Context, in *CreateRatingR
 	return out, nil
 }
 
+func (c *movieServiceClient) GetMyRating(ctx context.Context, in *GetMyRatingRequest, opts ...grpc.CallOption) (*Rating, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rating)
+	err := c.cc.Invoke(ctx, MovieService_GetMyRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -158,339 +363,1194 @@ func (c *movieServiceClient) ListComments(ctx context.Context, in *ListCommentsR
 	return out, nil
 }
 
-func (c *movieServiceClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Comment)
-	err := c.cc.Invoke(ctx, MovieService_GetComment_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func (c *movieServiceClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, MovieService_GetComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, MovieService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, MovieService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MovieService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGenresResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_CreateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*UpdateGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_UpdateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MovieService_DeleteGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeopleResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListPeople_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Person)
+	err := c.cc.Invoke(ctx, MovieService_GetPerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePersonResponse)
+	err := c.cc.Invoke(ctx, MovieService_CreatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePersonResponse)
+	err := c.cc.Invoke(ctx, MovieService_UpdatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MovieService_DeletePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListFilmography(ctx context.Context, in *ListFilmographyRequest, opts ...grpc.CallOption) (*ListFilmographyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFilmographyResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListFilmography_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieCredits(ctx context.Context, in *SetMovieCreditsRequest, opts ...grpc.CallOption) (*Movie, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MovieService_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpisodesResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, MovieService_GetEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetNextEpisode(ctx context.Context, in *GetNextEpisodeRequest, opts ...grpc.CallOption) (*GetNextEpisodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextEpisodeResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetNextEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MovieServiceServer is the server API for MovieService service.
+// All implementations must embed UnimplementedMovieServiceServer
+// for forward compatibility.
+//
+// ----- Сервис с RPC-методами, соответствующими REST-эндпоинтам -----
+// Хотя мы используем REST/HTTP+JSON↔Protobuf, здесь показываем gRPC-интерфейс
+// для удобства генерации Protobuf-моделей. При интеграции с gouber
+// будем маппить HTTP-маршруты на эти методы.
+type MovieServiceServer interface {
+	// Работа с фильмами
+	ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error)
+	GetMovie(context.Context, *GetMovieRequest) (*Movie, error)
+	CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieResponse, error)
+	DeleteMovie(context.Context, *DeleteMovieRequest) (*emptypb.Empty, error)
+	RestoreMovie(context.Context, *RestoreMovieRequest) (*Movie, error)
+	PurgeMovie(context.Context, *PurgeMovieRequest) (*emptypb.Empty, error)
+	PublishMovie(context.Context, *PublishMovieRequest) (*Movie, error)
+	ScheduleMovie(context.Context, *ScheduleMovieRequest) (*Movie, error)
+	ArchiveMovie(context.Context, *ArchiveMovieRequest) (*Movie, error)
+	RevertMovieToDraft(context.Context, *RevertMovieToDraftRequest) (*Movie, error)
+	UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error)
+	PatchMovie(context.Context, *PatchMovieRequest) (*UpdateMovieResponse, error)
+	SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error)
+	ListMovieTranslations(context.Context, *ListMovieTranslationsRequest) (*ListMovieTranslationsResponse, error)
+	SetMovieTranslation(context.Context, *SetMovieTranslationRequest) (*MovieTranslation, error)
+	DeleteMovieTranslation(context.Context, *DeleteMovieTranslationRequest) (*emptypb.Empty, error)
+	SetMovieCertifications(context.Context, *SetMovieCertificationsRequest) (*Movie, error)
+	// Работа с рейтингами
+	ListRatings(context.Context, *ListRatingsRequest) (*ListRatingsResponse, error)
+	GetRating(context.Context, *GetRatingRequest) (*Rating, error)
+	CreateRating(context.Context, *CreateRatingRequest) (*CreateRatingResponse, error)
+	GetMyRating(context.Context, *GetMyRatingRequest) (*Rating, error)
+	DeleteRating(context.Context, *DeleteRatingRequest) (*emptypb.Empty, error)
+	// Работа с комментариями
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	GetComment(context.Context, *GetCommentRequest) (*Comment, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	// Работа с жанрами
+	ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error)
+	CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error)
+	UpdateGenre(context.Context, *UpdateGenreRequest) (*UpdateGenreResponse, error)
+	DeleteGenre(context.Context, *DeleteGenreRequest) (*emptypb.Empty, error)
+	// Работа с персонами и титрами
+	ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error)
+	GetPerson(context.Context, *GetPersonRequest) (*Person, error)
+	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error)
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error)
+	DeletePerson(context.Context, *DeletePersonRequest) (*emptypb.Empty, error)
+	ListFilmography(context.Context, *ListFilmographyRequest) (*ListFilmographyResponse, error)
+	SetMovieCredits(context.Context, *SetMovieCreditsRequest) (*Movie, error)
+	// Работа с сериалами
+	ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error)
+	GetSeries(context.Context, *GetSeriesRequest) (*Series, error)
+	ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error)
+	GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error)
+	GetNextEpisode(context.Context, *GetNextEpisodeRequest) (*GetNextEpisodeResponse, error)
+	mustEmbedUnimplementedMovieServiceServer()
+}
+
+// UnimplementedMovieServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMovieServiceServer struct{}
+
+func (UnimplementedMovieServiceServer) ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) GetMovie(context.Context, *GetMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovie(context.Context, *DeleteMovieRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) RestoreMovie(context.Context, *RestoreMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) PurgeMovie(context.Context, *PurgeMovieRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) PublishMovie(context.Context, *PublishMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) ScheduleMovie(context.Context, *ScheduleMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) ArchiveMovie(context.Context, *ArchiveMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) RevertMovieToDraft(context.Context, *RevertMovieToDraftRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertMovieToDraft not implemented")
+}
+func (UnimplementedMovieServiceServer) UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) PatchMovie(context.Context, *PatchMovieRequest) (*UpdateMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) ListMovieTranslations(context.Context, *ListMovieTranslationsRequest) (*ListMovieTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMovieTranslations not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieTranslation(context.Context, *SetMovieTranslationRequest) (*MovieTranslation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieTranslation not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovieTranslation(context.Context, *DeleteMovieTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovieTranslation not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieCertifications(context.Context, *SetMovieCertificationsRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieCertifications not implemented")
+}
+func (UnimplementedMovieServiceServer) ListRatings(context.Context, *ListRatingsRequest) (*ListRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRatings not implemented")
+}
+func (UnimplementedMovieServiceServer) GetRating(context.Context, *GetRatingRequest) (*Rating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRating not implemented")
+}
+func (UnimplementedMovieServiceServer) CreateRating(context.Context, *CreateRatingRequest) (*CreateRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRating not implemented")
+}
+func (UnimplementedMovieServiceServer) GetMyRating(context.Context, *GetMyRatingRequest) (*Rating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyRating not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteRating(context.Context, *DeleteRatingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRating not implemented")
+}
+func (UnimplementedMovieServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedMovieServiceServer) GetComment(context.Context, *GetCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (UnimplementedMovieServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedMovieServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedMovieServiceServer) ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
+}
+func (UnimplementedMovieServiceServer) CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) UpdateGenre(context.Context, *UpdateGenreRequest) (*UpdateGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteGenre(context.Context, *DeleteGenreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeople not implemented")
+}
+func (UnimplementedMovieServiceServer) GetPerson(context.Context, *GetPersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedMovieServiceServer) CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedMovieServiceServer) UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerson not implemented")
+}
+func (UnimplementedMovieServiceServer) DeletePerson(context.Context, *DeletePersonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePerson not implemented")
+}
+func (UnimplementedMovieServiceServer) ListFilmography(context.Context, *ListFilmographyRequest) (*ListFilmographyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilmography not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieCredits(context.Context, *SetMovieCreditsRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieCredits not implemented")
+}
+func (UnimplementedMovieServiceServer) ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeries not implemented")
+}
+func (UnimplementedMovieServiceServer) GetSeries(context.Context, *GetSeriesRequest) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedMovieServiceServer) ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpisodes not implemented")
+}
+func (UnimplementedMovieServiceServer) GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpisode not implemented")
+}
+func (UnimplementedMovieServiceServer) GetNextEpisode(context.Context, *GetNextEpisodeRequest) (*GetNextEpisodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextEpisode not implemented")
+}
+func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
+func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeMovieServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MovieServiceServer will
+// result in compilation errors.
+type UnsafeMovieServiceServer interface {
+	mustEmbedUnimplementedMovieServiceServer()
+}
+
+func RegisterMovieServiceServer(s grpc.ServiceRegistrar, srv MovieServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMovieServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MovieService_ServiceDesc, srv)
+}
+
+func _MovieService_ListMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListMovies(ctx, req.(*ListMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetMovie(ctx, req.(*GetMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_CreateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).CreateMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_CreateMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).CreateMovie(ctx, req.(*CreateMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovie(ctx, req.(*DeleteMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_RestoreMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).RestoreMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_RestoreMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).RestoreMovie(ctx, req.(*RestoreMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PurgeMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PurgeMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_PurgeMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PurgeMovie(ctx, req.(*PurgeMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PublishMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PublishMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_PublishMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PublishMovie(ctx, req.(*PublishMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ScheduleMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ScheduleMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ScheduleMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ScheduleMovie(ctx, req.(*ScheduleMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ArchiveMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ArchiveMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ArchiveMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ArchiveMovie(ctx, req.(*ArchiveMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_RevertMovieToDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertMovieToDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).RevertMovieToDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_RevertMovieToDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).RevertMovieToDraft(ctx, req.(*RevertMovieToDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UpdateMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, req.(*UpdateMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PatchMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PatchMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_PatchMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PatchMovie(ctx, req.(*PatchMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SearchMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SearchMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SearchMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SearchMovies(ctx, req.(*SearchMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListMovieTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMovieTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListMovieTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListMovieTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListMovieTranslations(ctx, req.(*ListMovieTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieTranslation(ctx, req.(*SetMovieTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovieTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovieTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovieTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovieTranslation(ctx, req.(*DeleteMovieTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieCertifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieCertificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieCertifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieCertifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieCertifications(ctx, req.(*SetMovieCertificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListRatings(ctx, req.(*ListRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetRating(ctx, req.(*GetRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_CreateRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).CreateRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_CreateRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).CreateRating(ctx, req.(*CreateRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetMyRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetMyRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetMyRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetMyRating(ctx, req.(*GetMyRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteRating(ctx, req.(*DeleteRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetComment(ctx, req.(*GetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListGenres(ctx, req.(*ListGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_CreateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGenreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MovieServiceServer).CreateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_CreateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).CreateGenre(ctx, req.(*CreateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *movieServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateCommentResponse)
-	err := c.cc.Invoke(ctx, MovieService_CreateComment_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MovieService_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGenreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UpdateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UpdateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UpdateGenre(ctx, req.(*UpdateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *movieServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, MovieService_DeleteComment_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MovieService_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGenreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// MovieServiceServer is the server API for MovieService service.
-// All implementations must embed UnimplementedMovieServiceServer
-// for forward compatibility.
-//
-// ----- Сервис с RPC-методами, соответствующими REST-эндпоинтам -----
-// Хотя мы используем REST/HTTP+JSON↔Protobuf, здесь показываем gRPC-интерфейс
-// для удобства генерации Protobuf-моделей. При интеграции с gouber
-// будем маппить HTTP-маршруты на эти методы.
-type MovieServiceServer interface {
-	// Работа с фильмами
-	ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error)
-	GetMovie(context.Context, *GetMovieRequest) (*Movie, error)
-	CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieResponse, error)
-	DeleteMovie(context.Context, *DeleteMovieRequest) (*emptypb.Empty, error)
-	// Работа с рейтингами
-	ListRatings(context.Context, *ListRatingsRequest) (*ListRatingsResponse, error)
-	GetRating(context.Context, *GetRatingRequest) (*Rating, error)
-	CreateRating(context.Context, *CreateRatingRequest) (*CreateRatingResponse, error)
-	DeleteRating(context.Context, *DeleteRatingRequest) (*emptypb.Empty, error)
-	// Работа с комментариями
-	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
-	GetComment(context.Context, *GetCommentRequest) (*Comment, error)
-	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
-	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedMovieServiceServer()
-}
-
-// UnimplementedMovieServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMovieServiceServer struct{}
-
-func (UnimplementedMovieServiceServer) ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMovies not implemented")
-}
-func (UnimplementedMovieServiceServer) GetMovie(context.Context, *GetMovieRequest) (*Movie, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMovie not implemented")
-}
-func (UnimplementedMovieServiceServer) CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMovie not implemented")
-}
-func (UnimplementedMovieServiceServer) DeleteMovie(context.Context, *DeleteMovieRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
-}
-func (UnimplementedMovieServiceServer) ListRatings(context.Context, *ListRatingsRequest) (*ListRatingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRatings not implemented")
-}
-func (UnimplementedMovieServiceServer) GetRating(context.Context, *GetRatingRequest) (*Rating, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRating not implemented")
-}
-func (UnimplementedMovieServiceServer) CreateRating(context.Context, *CreateRatingRequest) (*CreateRatingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRating not implemented")
-}
-func (UnimplementedMovieServiceServer) DeleteRating(context.Context, *DeleteRatingRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRating not implemented")
-}
-func (UnimplementedMovieServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
-}
-func (UnimplementedMovieServiceServer) GetComment(context.Context, *GetCommentRequest) (*Comment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
-}
-func (UnimplementedMovieServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
-}
-func (UnimplementedMovieServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
-}
-func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
-func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
-
-// UnsafeMovieServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MovieServiceServer will
-// result in compilation errors.
-type UnsafeMovieServiceServer interface {
-	mustEmbedUnimplementedMovieServiceServer()
-}
-
-func RegisterMovieServiceServer(s grpc.ServiceRegistrar, srv MovieServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMovieServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteGenre(ctx, in)
 	}
-	s.RegisterService(&MovieService_ServiceDesc, srv)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteGenre(ctx, req.(*DeleteGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_ListMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMoviesRequest)
+func _MovieService_ListPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeopleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).ListMovies(ctx, in)
+		return srv.(MovieServiceServer).ListPeople(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_ListMovies_FullMethodName,
+		FullMethod: MovieService_ListPeople_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).ListMovies(ctx, req.(*ListMoviesRequest))
+		return srv.(MovieServiceServer).ListPeople(ctx, req.(*ListPeopleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_GetMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMovieRequest)
+func _MovieService_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).GetMovie(ctx, in)
+		return srv.(MovieServiceServer).GetPerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_GetMovie_FullMethodName,
+		FullMethod: MovieService_GetPerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).GetMovie(ctx, req.(*GetMovieRequest))
+		return srv.(MovieServiceServer).GetPerson(ctx, req.(*GetPersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_CreateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMovieRequest)
+func _MovieService_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).CreateMovie(ctx, in)
+		return srv.(MovieServiceServer).CreatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_CreateMovie_FullMethodName,
+		FullMethod: MovieService_CreatePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).CreateMovie(ctx, req.(*CreateMovieRequest))
+		return srv.(MovieServiceServer).CreatePerson(ctx, req.(*CreatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMovieRequest)
+func _MovieService_UpdatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).DeleteMovie(ctx, in)
+		return srv.(MovieServiceServer).UpdatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_DeleteMovie_FullMethodName,
+		FullMethod: MovieService_UpdatePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).DeleteMovie(ctx, req.(*DeleteMovieRequest))
+		return srv.(MovieServiceServer).UpdatePerson(ctx, req.(*UpdatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_ListRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRatingsRequest)
+func _MovieService_DeletePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).ListRatings(ctx, in)
+		return srv.(MovieServiceServer).DeletePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_ListRatings_FullMethodName,
+		FullMethod: MovieService_DeletePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).ListRatings(ctx, req.(*ListRatingsRequest))
+		return srv.(MovieServiceServer).DeletePerson(ctx, req.(*DeletePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_GetRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRatingRequest)
+func _MovieService_ListFilmography_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilmographyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).GetRating(ctx, in)
+		return srv.(MovieServiceServer).ListFilmography(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_GetRating_FullMethodName,
+		FullMethod: MovieService_ListFilmography_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).GetRating(ctx, req.(*GetRatingRequest))
+		return srv.(MovieServiceServer).ListFilmography(ctx, req.(*ListFilmographyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_CreateRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRatingRequest)
+func _MovieService_SetMovieCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieCreditsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).CreateRating(ctx, in)
+		return srv.(MovieServiceServer).SetMovieCredits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_CreateRating_FullMethodName,
+		FullMethod: MovieService_SetMovieCredits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).CreateRating(ctx, req.(*CreateRatingRequest))
+		return srv.(MovieServiceServer).SetMovieCredits(ctx, req.(*SetMovieCreditsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_DeleteRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRatingRequest)
+func _MovieService_ListSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).DeleteRating(ctx, in)
+		return srv.(MovieServiceServer).ListSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_DeleteRating_FullMethodName,
+		FullMethod: MovieService_ListSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).DeleteRating(ctx, req.(*DeleteRatingRequest))
+		return srv.(MovieServiceServer).ListSeries(ctx, req.(*ListSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommentsRequest)
+func _MovieService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).ListComments(ctx, in)
+		return srv.(MovieServiceServer).GetSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_ListComments_FullMethodName,
+		FullMethod: MovieService_GetSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+		return srv.(MovieServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentRequest)
+func _MovieService_ListEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpisodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).GetComment(ctx, in)
+		return srv.(MovieServiceServer).ListEpisodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_GetComment_FullMethodName,
+		FullMethod: MovieService_ListEpisodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).GetComment(ctx, req.(*GetCommentRequest))
+		return srv.(MovieServiceServer).ListEpisodes(ctx, req.(*ListEpisodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentRequest)
+func _MovieService_GetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).CreateComment(ctx, in)
+		return srv.(MovieServiceServer).GetEpisode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_CreateComment_FullMethodName,
+		FullMethod: MovieService_GetEpisode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+		return srv.(MovieServiceServer).GetEpisode(ctx, req.(*GetEpisodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentRequest)
+func _MovieService_GetNextEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextEpisodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieServiceServer).DeleteComment(ctx, in)
+		return srv.(MovieServiceServer).GetNextEpisode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieService_DeleteComment_FullMethodName,
+		FullMethod: MovieService_GetNextEpisode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+		return srv.(MovieServiceServer).GetNextEpisode(ctx, req.(*GetNextEpisodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -518,6 +1578,58 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMovie",
 			Handler:    _MovieService_DeleteMovie_Handler,
 		},
+		{
+			MethodName: "RestoreMovie",
+			Handler:    _MovieService_RestoreMovie_Handler,
+		},
+		{
+			MethodName: "PurgeMovie",
+			Handler:    _MovieService_PurgeMovie_Handler,
+		},
+		{
+			MethodName: "PublishMovie",
+			Handler:    _MovieService_PublishMovie_Handler,
+		},
+		{
+			MethodName: "ScheduleMovie",
+			Handler:    _MovieService_ScheduleMovie_Handler,
+		},
+		{
+			MethodName: "ArchiveMovie",
+			Handler:    _MovieService_ArchiveMovie_Handler,
+		},
+		{
+			MethodName: "RevertMovieToDraft",
+			Handler:    _MovieService_RevertMovieToDraft_Handler,
+		},
+		{
+			MethodName: "UpdateMovie",
+			Handler:    _MovieService_UpdateMovie_Handler,
+		},
+		{
+			MethodName: "PatchMovie",
+			Handler:    _MovieService_PatchMovie_Handler,
+		},
+		{
+			MethodName: "SearchMovies",
+			Handler:    _MovieService_SearchMovies_Handler,
+		},
+		{
+			MethodName: "ListMovieTranslations",
+			Handler:    _MovieService_ListMovieTranslations_Handler,
+		},
+		{
+			MethodName: "SetMovieTranslation",
+			Handler:    _MovieService_SetMovieTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteMovieTranslation",
+			Handler:    _MovieService_DeleteMovieTranslation_Handler,
+		},
+		{
+			MethodName: "SetMovieCertifications",
+			Handler:    _MovieService_SetMovieCertifications_Handler,
+		},
 		{
 			MethodName: "ListRatings",
 			Handler:    _MovieService_ListRatings_Handler,
@@ -530,6 +1642,10 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRating",
 			Handler:    _MovieService_CreateRating_Handler,
 		},
+		{
+			MethodName: "GetMyRating",
+			Handler:    _MovieService_GetMyRating_Handler,
+		},
 		{
 			MethodName: "DeleteRating",
 			Handler:    _MovieService_DeleteRating_Handler,
@@ -546,10 +1662,78 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateComment",
 			Handler:    _MovieService_CreateComment_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _MovieService_EditComment_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _MovieService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "ListGenres",
+			Handler:    _MovieService_ListGenres_Handler,
+		},
+		{
+			MethodName: "CreateGenre",
+			Handler:    _MovieService_CreateGenre_Handler,
+		},
+		{
+			MethodName: "UpdateGenre",
+			Handler:    _MovieService_UpdateGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _MovieService_DeleteGenre_Handler,
+		},
+		{
+			MethodName: "ListPeople",
+			Handler:    _MovieService_ListPeople_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _MovieService_GetPerson_Handler,
+		},
+		{
+			MethodName: "CreatePerson",
+			Handler:    _MovieService_CreatePerson_Handler,
+		},
+		{
+			MethodName: "UpdatePerson",
+			Handler:    _MovieService_UpdatePerson_Handler,
+		},
+		{
+			MethodName: "DeletePerson",
+			Handler:    _MovieService_DeletePerson_Handler,
+		},
+		{
+			MethodName: "ListFilmography",
+			Handler:    _MovieService_ListFilmography_Handler,
+		},
+		{
+			MethodName: "SetMovieCredits",
+			Handler:    _MovieService_SetMovieCredits_Handler,
+		},
+		{
+			MethodName: "ListSeries",
+			Handler:    _MovieService_ListSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _MovieService_GetSeries_Handler,
+		},
+		{
+			MethodName: "ListEpisodes",
+			Handler:    _MovieService_ListEpisodes_Handler,
+		},
+		{
+			MethodName: "GetEpisode",
+			Handler:    _MovieService_GetEpisode_Handler,
+		},
+		{
+			MethodName: "GetNextEpisode",
+			Handler:    _MovieService_GetNextEpisode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/movie.proto",